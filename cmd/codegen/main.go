@@ -0,0 +1,75 @@
+// Command codegen generates client models, Terraform attribute types,
+// baseline data source schemas and getters from an OpenAPI 3 document of the
+// panel API, so a new endpoint starts from generated code instead of
+// hand-written anonymous structs:
+//
+//	go run ./cmd/codegen -spec=panel.json -schemas=Location -operations=getLocation -out=internal/provider/locations_gen.go
+//
+// The document must be JSON. Without -schemas and -operations every
+// component schema and every GET operation returning an `attributes` object
+// is generated. The output is a baseline: resources and data sources are
+// still written by hand on top of it, marking attributes Optional or
+// Required as the endpoint needs.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Sidler1/terraform-provider-kineticpanel/internal/codegen"
+)
+
+func main() {
+	var (
+		specPath   string
+		out        string
+		schemas    string
+		operations string
+		opts       codegen.Options
+	)
+	flag.StringVar(&specPath, "spec", "", "OpenAPI 3 document (JSON)")
+	flag.StringVar(&out, "out", "", "file to write; standard output when empty")
+	flag.StringVar(&schemas, "schemas", "", "comma-separated component schemas to generate")
+	flag.StringVar(&operations, "operations", "", "comma-separated operationIds of GET operations to generate getters for")
+	flag.StringVar(&opts.Package, "package", "provider", "package of the generated file")
+	flag.StringVar(&opts.Prefix, "prefix", "", "prefix for every generated identifier, e.g. gen")
+	flag.Parse()
+
+	if specPath == "" {
+		log.Fatal("-spec is required")
+	}
+	spec, err := codegen.Load(specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	opts.Source = filepath.Base(specPath)
+	opts.Schemas = splitList(schemas)
+	opts.Operations = splitList(operations)
+
+	src, err := codegen.Generate(spec, opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if out == "" {
+		fmt.Print(string(src))
+		return
+	}
+	if err := os.WriteFile(out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// splitList splits a comma-separated flag value, dropping empty entries.
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,51 @@
+// Command sweep deletes servers, users and backups left behind by failed
+// acceptance test runs. It reads the panel from KINETICPANEL_HOST and
+// KINETICPANEL_API_KEY and only touches objects named with -prefix.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+
+	"github.com/Sidler1/terraform-provider-kineticpanel/internal/provider"
+)
+
+func main() {
+	var (
+		prefix         string
+		dryRun         bool
+		useApplication bool
+	)
+	flag.StringVar(&prefix, "prefix", provider.SweepPrefix, "name prefix of the objects to delete")
+	flag.BoolVar(&dryRun, "dry-run", false, "list what would be deleted without deleting it")
+	flag.BoolVar(&useApplication, "application", true, "use the Application API (servers, users); false sweeps backups with a Client key")
+	flag.Parse()
+
+	host := os.Getenv("KINETICPANEL_HOST")
+	if host == "" {
+		host = "https://kineticpanel.net"
+	}
+	apiKey := os.Getenv("KINETICPANEL_API_KEY")
+	if apiKey == "" {
+		log.Fatal("KINETICPANEL_API_KEY is not set")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	swept, err := provider.Sweep(ctx, host, apiKey, useApplication, prefix, dryRun)
+	verb := "deleted"
+	if dryRun {
+		verb = "would delete"
+	}
+	for _, s := range swept {
+		fmt.Printf("%s %s\n", verb, s)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
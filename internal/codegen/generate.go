@@ -0,0 +1,637 @@
+package codegen
+
+//go:generate go run ../../cmd/codegen -spec=testdata/panel.json -out=testdata/panel.golden
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Options select what to generate.
+type Options struct {
+	Package    string   // package of the generated file
+	Prefix     string   // prepended to every generated identifier, to keep clear of hand-written ones
+	Schemas    []string // component schemas to generate; empty means all of them
+	Operations []string // operationIds of GET operations to generate getters for; empty means all unless Schemas is set
+	Source     string   // name of the document, for the header
+}
+
+// Generate returns a formatted Go file with, for every selected schema and
+// every schema they reference:
+//
+//   - a model struct with json and tfsdk tags, decoding the schema,
+//   - a map of its Terraform attribute types, for converting the model
+//     with types.ObjectValueFrom,
+//   - a function returning computed data source attributes for it,
+//
+// and a getter for every selected GET operation that returns an
+// `attributes` object. Properties without a fixed shape (free-form
+// objects) decode to json.RawMessage and are left out of the Terraform side.
+func Generate(spec *Spec, opts Options) ([]byte, error) {
+	g := &generator{spec: spec, opts: opts, done: map[string]bool{}, imports: map[string]bool{}}
+
+	// Getters first: they add their response models to the queue.
+	if err := g.operations(); err != nil {
+		return nil, err
+	}
+	if len(opts.Schemas) > 0 {
+		g.queue = append(g.queue, opts.Schemas...)
+	} else if len(opts.Operations) == 0 {
+		g.queue = append(g.queue, sortedKeys(spec.Components.Schemas)...)
+	}
+	for len(g.queue) > 0 {
+		name := g.queue[0]
+		g.queue = g.queue[1:]
+		if g.done[name] {
+			continue
+		}
+		g.done[name] = true
+		if err := g.model(name); err != nil {
+			return nil, fmt.Errorf("schema %s: %w", name, err)
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by cmd/codegen from %s; DO NOT EDIT.\n\npackage %s\n\n", opts.Source, opts.Package)
+	if len(g.imports) > 0 {
+		// Standard library first, as goimports groups them.
+		imports := sortedKeys(g.imports)
+		sort.SliceStable(imports, func(i, j int) bool {
+			return !strings.Contains(imports[i], ".") && strings.Contains(imports[j], ".")
+		})
+		out.WriteString("import (\n")
+		for i, imp := range imports {
+			if i > 0 && strings.Contains(imp, ".") && !strings.Contains(imports[i-1], ".") {
+				out.WriteString("\n")
+			}
+			fmt.Fprintf(&out, "\t%q\n", imp)
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(g.models.Bytes())
+	out.Write(g.getters.Bytes())
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w", err)
+	}
+	return src, nil
+}
+
+type generator struct {
+	spec    *Spec
+	opts    Options
+	queue   []string        // component schemas still to generate
+	done    map[string]bool // component schemas generated or queued earlier
+	imports map[string]bool
+	models  bytes.Buffer
+	getters bytes.Buffer
+}
+
+const (
+	importAttr   = "github.com/hashicorp/terraform-plugin-framework/attr"
+	importSchema = "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	importTypes  = "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// model writes the struct, attribute types and data source attributes of a
+// component schema.
+func (g *generator) model(name string) error {
+	s, ok := g.spec.Components.Schemas[name]
+	if !ok {
+		return fmt.Errorf("no such schema")
+	}
+	s, _, err := g.spec.resolve(s)
+	if err != nil {
+		return err
+	}
+	if len(s.Properties) == 0 {
+		return fmt.Errorf("only objects with properties can be generated")
+	}
+	g.imports[importAttr] = true
+	g.imports[importSchema] = true
+	g.imports[importTypes] = true
+
+	typ := g.ident(name)
+	fields, err := g.structFields(s)
+	if err != nil {
+		return err
+	}
+	attrTypes, err := g.attrTypeEntries(s)
+	if err != nil {
+		return err
+	}
+	attrs, err := g.attributeEntries(s)
+	if err != nil {
+		return err
+	}
+
+	if s.Description != "" {
+		fmt.Fprintf(&g.models, "// %s is the %s schema: %s\n", typ, name, oneLine(s.Description))
+	} else {
+		fmt.Fprintf(&g.models, "// %s is the %s schema.\n", typ, name)
+	}
+	fmt.Fprintf(&g.models, "type %s struct {\n%s}\n\n", typ, fields)
+	fmt.Fprintf(&g.models, "// %sAttrTypes are the Terraform types of %s.\n", typ, typ)
+	fmt.Fprintf(&g.models, "var %sAttrTypes = map[string]attr.Type{\n%s}\n\n", typ, attrTypes)
+	fmt.Fprintf(&g.models, "// %sDataSourceAttributes returns computed data source attributes for %s.\n", typ, typ)
+	fmt.Fprintf(&g.models, "func %sDataSourceAttributes() map[string]schema.Attribute {\n\treturn map[string]schema.Attribute{\n%s}\n}\n\n", typ, attrs)
+	return nil
+}
+
+// structFields returns the fields of an object schema, sorted by JSON name.
+// Fields left out of the attribute types are tagged `tfsdk:"-"`, so
+// types.ObjectValueFrom skips them.
+func (g *generator) structFields(s *Schema) (string, error) {
+	var b strings.Builder
+	for _, prop := range sortedKeys(s.Properties) {
+		t, err := g.goType(s.Properties[prop])
+		if err != nil {
+			return "", fmt.Errorf("property %s: %w", prop, err)
+		}
+		_, ok, err := g.attrType(s.Properties[prop])
+		if err != nil {
+			return "", fmt.Errorf("property %s: %w", prop, err)
+		}
+		tfsdk := prop
+		if !ok {
+			tfsdk = "-"
+		}
+		fmt.Fprintf(&b, "\t%s %s `json:%q tfsdk:%q`\n", exportedName(prop), t, prop, tfsdk)
+	}
+	return b.String(), nil
+}
+
+// goType returns the Go type decoding s. Nullable scalars and objects are
+// pointers; nullable lists and maps decode to nil.
+func (g *generator) goType(s *Schema) (string, error) {
+	r, name, err := g.spec.resolve(s)
+	if err != nil {
+		return "", err
+	}
+	nullable := s.nullable() || r.nullable()
+	ptr := func(t string) string {
+		if nullable {
+			return "*" + t
+		}
+		return t
+	}
+	if name != "" && len(r.Properties) > 0 {
+		g.enqueue(name)
+		return ptr(g.ident(name)), nil
+	}
+	switch r.Type.Name {
+	case "string":
+		return ptr("string"), nil
+	case "integer":
+		return ptr("int64"), nil
+	case "number":
+		return ptr("float64"), nil
+	case "boolean":
+		return ptr("bool"), nil
+	case "array":
+		if r.Items == nil {
+			return "", fmt.Errorf("array without items")
+		}
+		elem, err := g.goType(r.Items)
+		return "[]" + elem, err
+	}
+	if len(r.Properties) > 0 {
+		fields, err := g.structFields(r)
+		return ptr("struct {\n" + fields + "}"), err
+	}
+	add, err := r.additional()
+	if err != nil {
+		return "", err
+	}
+	if add.typed() {
+		elem, err := g.goType(add)
+		return "map[string]" + elem, err
+	}
+	g.imports["encoding/json"] = true
+	return "json.RawMessage", nil
+}
+
+// shape is what a schema maps to on the Terraform side.
+type shape int
+
+const (
+	shapeNone   shape = iota // free-form, left out
+	shapeScalar              // string, number, bool
+	shapeObject              // object with properties
+	shapeList                // array
+	shapeMap                 // object with additionalProperties
+)
+
+// tfShape classifies s and returns its resolved schema and component name.
+func (g *generator) tfShape(s *Schema) (shape, *Schema, string, error) {
+	r, name, err := g.spec.resolve(s)
+	if err != nil {
+		return shapeNone, nil, "", err
+	}
+	switch {
+	case len(r.Properties) > 0:
+		return shapeObject, r, name, nil
+	case r.Type.Name == "array":
+		if r.Items == nil {
+			return shapeNone, nil, "", fmt.Errorf("array without items")
+		}
+		return shapeList, r, name, nil
+	case slices.Contains([]string{"string", "integer", "number", "boolean"}, r.Type.Name):
+		return shapeScalar, r, name, nil
+	}
+	add, err := r.additional()
+	if err != nil {
+		return shapeNone, nil, "", err
+	}
+	if add.typed() {
+		return shapeMap, r, name, nil
+	}
+	return shapeNone, r, name, nil
+}
+
+// attrType returns the Terraform type expression of s; ok is false for
+// free-form values.
+func (g *generator) attrType(s *Schema) (expr string, ok bool, err error) {
+	sh, r, name, err := g.tfShape(s)
+	if err != nil || sh == shapeNone {
+		return "", false, err
+	}
+	switch sh {
+	case shapeObject:
+		if name != "" {
+			return fmt.Sprintf("types.ObjectType{AttrTypes: %sAttrTypes}", g.ident(name)), true, nil
+		}
+		entries, err := g.attrTypeEntries(r)
+		return "types.ObjectType{AttrTypes: map[string]attr.Type{\n" + entries + "}}", err == nil, err
+	case shapeList:
+		elem, ok, err := g.attrType(r.Items)
+		return "types.ListType{ElemType: " + elem + "}", ok, err
+	case shapeMap:
+		add, _ := r.additional()
+		elem, ok, err := g.attrType(add)
+		return "types.MapType{ElemType: " + elem + "}", ok, err
+	}
+	return scalarTypes[r.Type.Name] + "Type", true, nil
+}
+
+// scalarTypes maps JSON schema types to Terraform types, less the "Type"
+// or "Attribute" suffix.
+var scalarTypes = map[string]string{
+	"string":  "types.String",
+	"integer": "types.Int64",
+	"number":  "types.Float64",
+	"boolean": "types.Bool",
+}
+
+// attrTypeEntries returns the entries of an attribute type map for an
+// object schema.
+func (g *generator) attrTypeEntries(s *Schema) (string, error) {
+	var b strings.Builder
+	for _, prop := range sortedKeys(s.Properties) {
+		expr, ok, err := g.attrType(s.Properties[prop])
+		if err != nil {
+			return "", fmt.Errorf("property %s: %w", prop, err)
+		}
+		if ok {
+			fmt.Fprintf(&b, "\t%q: %s,\n", prop, expr)
+		}
+	}
+	return b.String(), nil
+}
+
+// attributeEntries returns the entries of a data source attribute map for
+// an object schema.
+func (g *generator) attributeEntries(s *Schema) (string, error) {
+	var b strings.Builder
+	for _, prop := range sortedKeys(s.Properties) {
+		expr, ok, err := g.attribute(s.Properties[prop])
+		if err != nil {
+			return "", fmt.Errorf("property %s: %w", prop, err)
+		}
+		if ok {
+			fmt.Fprintf(&b, "\t%q: %s,\n", prop, expr)
+		}
+	}
+	return b.String(), nil
+}
+
+// attribute returns the computed data source attribute for s.
+func (g *generator) attribute(s *Schema) (expr string, ok bool, err error) {
+	sh, r, name, err := g.tfShape(s)
+	if err != nil || sh == shapeNone {
+		return "", false, err
+	}
+	desc := s.Description
+	if desc == "" {
+		desc = r.Description
+	}
+	common := "Computed: true"
+	if desc != "" {
+		common += fmt.Sprintf(", Description: %q", oneLine(desc))
+	}
+
+	// nested returns the attributes of an object schema.
+	nested := func(obj *Schema, name string) (string, error) {
+		if name != "" {
+			return g.ident(name) + "DataSourceAttributes()", nil
+		}
+		entries, err := g.attributeEntries(obj)
+		return "map[string]schema.Attribute{\n" + entries + "}", err
+	}
+
+	switch sh {
+	case shapeObject:
+		attrs, err := nested(r, name)
+		return fmt.Sprintf("schema.SingleNestedAttribute{%s, Attributes: %s}", common, attrs), err == nil, err
+	case shapeList, shapeMap:
+		elemSchema := r.Items
+		kind := "List"
+		if sh == shapeMap {
+			elemSchema, _ = r.additional()
+			kind = "Map"
+		}
+		elemShape, elem, elemName, err := g.tfShape(elemSchema)
+		if err != nil || elemShape == shapeNone {
+			return "", false, err
+		}
+		if elemShape == shapeObject {
+			attrs, err := nested(elem, elemName)
+			return fmt.Sprintf("schema.%sNestedAttribute{%s, NestedObject: schema.NestedAttributeObject{Attributes: %s}}", kind, common, attrs), err == nil, err
+		}
+		elemType, ok, err := g.attrType(elemSchema)
+		return fmt.Sprintf("schema.%sAttribute{%s, ElementType: %s}", kind, common, elemType), ok, err
+	}
+	return fmt.Sprintf("schema.%sAttribute{%s}", strings.TrimPrefix(scalarTypes[r.Type.Name], "types."), common), true, nil
+}
+
+// operations writes a getter for every selected GET operation.
+func (g *generator) operations() error {
+	if len(g.opts.Operations) == 0 && len(g.opts.Schemas) > 0 {
+		return nil
+	}
+	want := map[string]bool{}
+	for _, id := range g.opts.Operations {
+		want[id] = true
+	}
+	found := map[string]bool{}
+	for _, p := range sortedKeys(g.spec.Paths) {
+		item := g.spec.Paths[p]
+		op := item.Get
+		if op == nil || op.OperationID == "" || len(want) > 0 && !want[op.OperationID] {
+			continue
+		}
+		model, err := g.responseModel(op)
+		if err != nil {
+			return fmt.Errorf("operation %s: %w", op.OperationID, err)
+		}
+		if model == "" {
+			if want[op.OperationID] {
+				return fmt.Errorf("operation %s does not return an `attributes` object", op.OperationID)
+			}
+			continue
+		}
+		found[op.OperationID] = true
+		if err := g.getter(p, item, op, model); err != nil {
+			return fmt.Errorf("operation %s: %w", op.OperationID, err)
+		}
+	}
+	for id := range want {
+		if !found[id] {
+			return fmt.Errorf("no GET operation %s", id)
+		}
+	}
+	return nil
+}
+
+// responseModel returns the component schema of the `attributes` property
+// of the 200 response, or "" when the operation returns something else.
+func (g *generator) responseModel(op *Operation) (string, error) {
+	content, ok := op.Responses["200"].Content["application/json"]
+	if !ok || content.Schema == nil {
+		return "", nil
+	}
+	body, _, err := g.spec.resolve(content.Schema)
+	if err != nil {
+		return "", err
+	}
+	attrs, ok := body.Properties["attributes"]
+	if !ok {
+		return "", nil
+	}
+	_, name, err := g.spec.resolve(attrs)
+	if err != nil {
+		return "", err
+	}
+	if name == "" {
+		return "", fmt.Errorf("`attributes` is an inline schema; move it to components/schemas to generate it")
+	}
+	return name, nil
+}
+
+// pathParam matches a `{name}` placeholder in a path template.
+var pathParam = regexp.MustCompile(`\{([^}]+)\}`)
+
+// getter writes the function fetching the object of a GET operation.
+func (g *generator) getter(path string, item PathItem, op *Operation, model string) error {
+	g.enqueue(model)
+	g.imports["encoding/json"] = true
+	g.imports["fmt"] = true
+
+	params := map[string]Parameter{}
+	for _, p := range append(slices.Clone(item.Parameters), op.Parameters...) {
+		if p.In == "path" {
+			params[p.Name] = p
+		}
+	}
+
+	// The client's base URL already ends in /api/application or /api/client.
+	api := ""
+	for prefix, name := range map[string]string{"/api/application": "Application API", "/api/client": "Client API"} {
+		if strings.HasPrefix(path, prefix+"/") {
+			path, api = strings.TrimPrefix(path, prefix), name
+		}
+	}
+
+	var args, values []string
+	var err error
+	format := pathParam.ReplaceAllStringFunc(path, func(m string) string {
+		name := m[1 : len(m)-1]
+		p, ok := params[name]
+		if !ok {
+			err = fmt.Errorf("path parameter %s is not declared", name)
+			return m
+		}
+		arg := unexportedName(name)
+		if token.IsKeyword(arg) || arg == "client" || g.isModel(arg) {
+			arg += "ID"
+		}
+		goType, verb := "string", "%s"
+		if p.Schema != nil {
+			if r, _, rerr := g.spec.resolve(p.Schema); rerr == nil && r.Type.Name == "integer" {
+				goType, verb = "int64", "%d"
+			}
+		}
+		args = append(args, arg+" "+goType)
+		values = append(values, arg)
+		return verb
+	})
+	if err != nil {
+		return err
+	}
+
+	fn, typ := g.ident(op.OperationID), g.ident(model)
+	where := "GET " + path
+	if api != "" {
+		where += ", " + api
+	}
+	fmt.Fprintf(&g.getters, "// %s fetches the %s object (%s).", fn, model, where)
+	if op.Summary != "" {
+		fmt.Fprintf(&g.getters, " %s", oneLine(op.Summary))
+	}
+	g.getters.WriteString("\n")
+	fmt.Fprintf(&g.getters, "func %s(client *Client%s) (%s, error) {\n", fn, prependComma(args), typ)
+	if len(values) > 0 {
+		fmt.Fprintf(&g.getters, "\tbody, err := client.Get(fmt.Sprintf(%q, %s))\n", format, strings.Join(values, ", "))
+	} else {
+		fmt.Fprintf(&g.getters, "\tbody, err := client.Get(%q)\n", format)
+	}
+	fmt.Fprintf(&g.getters, `	if err != nil {
+		return %[1]s{}, err
+	}
+	var apiResp struct {
+		Attributes %[1]s `+"`json:\"attributes\"`"+`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return %[1]s{}, fmt.Errorf("JSON parse error: %%w", err)
+	}
+	return apiResp.Attributes, nil
+}
+
+`, typ)
+	return nil
+}
+
+// isModel reports whether ident names the model of a component schema.
+func (g *generator) isModel(ident string) bool {
+	for name := range g.spec.Components.Schemas {
+		if g.ident(name) == ident {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) enqueue(name string) {
+	if !g.done[name] && !slices.Contains(g.queue, name) {
+		g.queue = append(g.queue, name)
+	}
+}
+
+// ident returns the unexported identifier for a schema or operation name,
+// or Prefix followed by the exported form when a prefix is set.
+func (g *generator) ident(name string) string {
+	if g.opts.Prefix != "" {
+		return g.opts.Prefix + exportedName(name)
+	}
+	return unexportedName(name)
+}
+
+// initialisms are written in upper case in identifiers, as in the
+// hand-written models (ID, UUID, CPU).
+var initialisms = map[string]bool{
+	"API": true, "CPU": true, "DNS": true, "EULA": true, "HTTP": true, "ID": true, "IO": true, "IP": true,
+	"JSON": true, "OOM": true, "SFTP": true, "SSH": true, "TLS": true, "URL": true, "UUID": true,
+}
+
+// exportedName turns snake_case, kebab-case or camelCase into CamelCase.
+func exportedName(s string) string {
+	name := camel(words(s))
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "X" + name
+	}
+	return name
+}
+
+// unexportedName is exportedName with the first word in lower case.
+func unexportedName(s string) string {
+	ws := words(s)
+	if len(ws) == 0 {
+		return "x"
+	}
+	first := strings.ToLower(ws[0])
+	if unicode.IsDigit(rune(first[0])) {
+		first = "x" + first
+	}
+	return first + camel(ws[1:])
+}
+
+// camel joins words, capitalising each and upper-casing initialisms.
+func camel(ws []string) string {
+	var b strings.Builder
+	for _, w := range ws {
+		if up := strings.ToUpper(w); initialisms[up] {
+			b.WriteString(up)
+		} else {
+			b.WriteString(strings.ToUpper(w[:1]) + strings.ToLower(w[1:]))
+		}
+	}
+	return b.String()
+}
+
+// words splits an identifier on separators and case changes:
+// "DatabaseHost", "database_host" and "HTTPHost" give two words each.
+func words(s string) []string {
+	var out []string
+	var cur []rune
+	flush := func() {
+		if len(cur) > 0 {
+			out = append(out, string(cur))
+			cur = cur[:0]
+		}
+	}
+	rs := []rune(s)
+	for i, r := range rs {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if len(cur) > 0 && unicode.IsUpper(r) {
+			prev := cur[len(cur)-1]
+			nextLower := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && nextLower {
+				flush()
+			}
+		}
+		cur = append(cur, r)
+	}
+	flush()
+	return out
+}
+
+func prependComma(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return ", " + strings.Join(args, ", ")
+}
+
+// oneLine collapses whitespace so a description fits a comment or a string.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,77 @@
+package codegen
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite testdata/panel.golden")
+
+// TestGenerateGolden compares the output for testdata/panel.json, with the
+// defaults of cmd/codegen, to testdata/panel.golden. Run `go generate` or
+// `go test -update` after an intended change to the output.
+func TestGenerateGolden(t *testing.T) {
+	spec, err := Load("testdata/panel.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Generate(spec, Options{Package: "provider", Source: "panel.json"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	const golden = "testdata/panel.golden"
+	if *update {
+		if err := os.WriteFile(golden, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output differs from %s; run `go test -update` and review the diff\n%s", golden, got)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	spec, err := Load("testdata/panel.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]Options{
+		"unknown schema":    {Package: "provider", Schemas: []string{"Missing"}},
+		"unknown operation": {Package: "provider", Operations: []string{"getMissing"}},
+	}
+	for name, opts := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Generate(spec, opts); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		in, exported, unexported string
+	}{
+		{"database_host", "DatabaseHost", "databaseHost"},
+		{"DatabaseHost", "DatabaseHost", "databaseHost"},
+		{"HTTPHost", "HTTPHost", "httpHost"},
+		{"server-uuid", "ServerUUID", "serverUUID"},
+		{"cpu_absolute", "CPUAbsolute", "cpuAbsolute"},
+		{"getLocation", "GetLocation", "getLocation"},
+		{"2fa", "X2fa", "x2fa"},
+	}
+	for _, tt := range tests {
+		if got := exportedName(tt.in); got != tt.exported {
+			t.Errorf("exportedName(%q) = %q, want %q", tt.in, got, tt.exported)
+		}
+		if got := unexportedName(tt.in); got != tt.unexported {
+			t.Errorf("unexportedName(%q) = %q, want %q", tt.in, got, tt.unexported)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Package codegen generates client models and baseline Terraform schemas
+// from an OpenAPI 3 document describing the panel API. It backs
+// cmd/codegen and understands the subset of OpenAPI the panel endpoints
+// need: component schemas, $ref, allOf with one member, and GET operations
+// returning a single `attributes` object.
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Spec is the part of an OpenAPI document the generator reads.
+type Spec struct {
+	Paths      map[string]PathItem `json:"paths"`
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+// PathItem is one path of the document. Only GET operations are generated.
+type PathItem struct {
+	Get        *Operation  `json:"get"`
+	Parameters []Parameter `json:"parameters"`
+}
+
+// Operation is one operation on a path.
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary"`
+	Parameters  []Parameter         `json:"parameters"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter is a path, query or header parameter.
+type Parameter struct {
+	Name   string  `json:"name"`
+	In     string  `json:"in"`
+	Schema *Schema `json:"schema"`
+}
+
+// Response is one response of an operation, keyed by media type.
+type Response struct {
+	Content map[string]struct {
+		Schema *Schema `json:"schema"`
+	} `json:"content"`
+}
+
+// Schema is a JSON schema as used by OpenAPI 3.0 and 3.1.
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 schemaType         `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Nullable             bool               `json:"nullable"`
+	Properties           map[string]*Schema `json:"properties"`
+	Required             []string           `json:"required"`
+	Items                *Schema            `json:"items"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+	AllOf                []*Schema          `json:"allOf"`
+}
+
+// schemaType is the `type` of a schema, which OpenAPI 3.1 also allows as a
+// list such as ["string", "null"].
+type schemaType struct {
+	Name string
+	Null bool
+}
+
+func (t *schemaType) UnmarshalJSON(data []byte) error {
+	var one string
+	if json.Unmarshal(data, &one) == nil {
+		t.Name = one
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return fmt.Errorf("type must be a string or a list of strings: %w", err)
+	}
+	for _, name := range many {
+		if name == "null" {
+			t.Null = true
+		} else {
+			t.Name = name
+		}
+	}
+	return nil
+}
+
+// nullable reports whether the value may be null.
+func (s *Schema) nullable() bool {
+	return s.Nullable || s.Type.Null
+}
+
+// typed reports whether s describes a value of known shape, as opposed to
+// `{}` or a missing schema, which allow anything.
+func (s *Schema) typed() bool {
+	return s != nil && (s.Type.Name != "" || s.Ref != "" || len(s.Properties) > 0 || len(s.AllOf) > 0)
+}
+
+// additional returns the schema of additionalProperties, or nil when the
+// object has none. `true` allows values of any type.
+func (s *Schema) additional() (*Schema, error) {
+	raw := bytes.TrimSpace(s.AdditionalProperties)
+	switch string(raw) {
+	case "", "false":
+		return nil, nil
+	case "true", "{}":
+		return &Schema{}, nil
+	}
+	var add Schema
+	if err := json.Unmarshal(raw, &add); err != nil {
+		return nil, fmt.Errorf("additionalProperties: %w", err)
+	}
+	return &add, nil
+}
+
+// Load reads an OpenAPI document in JSON.
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] != '{' {
+		return nil, fmt.Errorf("%s is not JSON; convert a YAML document first, e.g. with `yq -o json`", path)
+	}
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return &spec, nil
+}
+
+// resolve follows $ref and single-member allOf. name is the component name
+// when the schema is a component, "" for inline schemas.
+func (sp *Spec) resolve(s *Schema) (resolved *Schema, name string, err error) {
+	for range 16 {
+		switch {
+		case s.Ref != "":
+			const prefix = "#/components/schemas/"
+			if !strings.HasPrefix(s.Ref, prefix) {
+				return nil, "", fmt.Errorf("unsupported $ref %q: only %s... is supported", s.Ref, prefix)
+			}
+			name = strings.TrimPrefix(s.Ref, prefix)
+			target, ok := sp.Components.Schemas[name]
+			if !ok {
+				return nil, "", fmt.Errorf("$ref %q: no such schema", s.Ref)
+			}
+			s = target
+		case len(s.AllOf) == 1:
+			s = s.AllOf[0]
+		case len(s.AllOf) > 1:
+			return nil, "", fmt.Errorf("allOf with %d members is not supported; merge them into one schema", len(s.AllOf))
+		default:
+			return s, name, nil
+		}
+	}
+	return nil, "", fmt.Errorf("$ref chain too deep at %q", s.Ref)
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Helpers around the Application API node allocation endpoints.
+
+// nodeAllocation is one allocation of a node as returned by the Application API.
+type nodeAllocation struct {
+	ID       int64   `json:"id"`
+	IP       string  `json:"ip"`
+	Alias    *string `json:"alias"`
+	Port     int64   `json:"port"`
+	Notes    *string `json:"notes"`
+	Assigned bool    `json:"assigned"`
+}
+
+func nodeAllocationsPath(nodeID int64) string {
+	return "/nodes/" + strconv.FormatInt(nodeID, 10) + "/allocations"
+}
+
+// listNodeAllocations returns every allocation of a node, walking all pages.
+func listNodeAllocations(client *Client, nodeID int64) ([]nodeAllocation, error) {
+	var allocations []nodeAllocation
+	for page, totalPages := 1, 1; page <= totalPages; page++ {
+		body, err := client.Get(fmt.Sprintf("%s?per_page=100&page=%d", nodeAllocationsPath(nodeID), page))
+		if err != nil {
+			return nil, err
+		}
+		var apiResp struct {
+			Data []struct {
+				Attributes nodeAllocation `json:"attributes"`
+			} `json:"data"`
+			Meta struct {
+				Pagination struct {
+					TotalPages int `json:"total_pages"`
+				} `json:"pagination"`
+			} `json:"meta"`
+		}
+		if err := json.Unmarshal(body, &apiResp); err != nil {
+			return nil, err
+		}
+		for _, d := range apiResp.Data {
+			allocations = append(allocations, d.Attributes)
+		}
+		totalPages = apiResp.Meta.Pagination.TotalPages
+	}
+	return allocations, nil
+}
+
+// createNodeAllocations adds ports (single ports or `start-end` ranges) on ip.
+// The panel skips ports that already exist, so repeating a call is harmless.
+func createNodeAllocations(client *Client, nodeID int64, ip, alias string, ports []string) error {
+	payload := map[string]any{
+		"ip":    ip,
+		"ports": ports,
+	}
+	if alias != "" {
+		payload["alias"] = alias
+	}
+	_, err := client.Post(nodeAllocationsPath(nodeID), payload)
+	return err
+}
+
+// deleteNodeAllocation removes an allocation. The panel refuses to delete
+// allocations that are assigned to a server.
+func deleteNodeAllocation(client *Client, nodeID, allocationID int64) error {
+	return client.Delete(nodeAllocationsPath(nodeID) + "/" + strconv.FormatInt(allocationID, 10))
+}
+
+// expandPorts turns `25565` and `25565-25570` entries into individual ports.
+func expandPorts(ports []string) ([]int64, error) {
+	var out []int64
+	for _, p := range ports {
+		lo, hi, err := parsePortRange(p, minAllocationPort)
+		if err != nil {
+			return nil, err
+		}
+		for port := lo; port <= hi; port++ {
+			out = append(out, port)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// APIError is a non-2xx answer from the panel. Callers inspect it through the
+// is* helpers below rather than by matching the message.
+type APIError struct {
+	StatusCode int
+	Code       string        // first panel error code, e.g. `NotFoundHttpException`; empty for non-JSON bodies
+	Detail     string        // first panel error detail, or a snippet of a non-JSON body
+	RetryAfter time.Duration // from the Retry-After header; zero when absent
+	message    string        // redacted body, as shown by Error
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error %d: %s", e.StatusCode, e.message)
+}
+
+// newAPIError builds an APIError from a response and its body. The panel
+// reports errors as `{"errors": [{"code": ..., "status": ..., "detail": ...}]}`.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	e := &APIError{StatusCode: resp.StatusCode}
+	if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs > 0 {
+		e.RetryAfter = time.Duration(secs) * time.Second
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if !strings.Contains(contentType, "json") {
+		// Proxy error pages are long HTML documents; keep the message readable.
+		e.Detail = bodySnippet(body)
+		e.message = fmt.Sprintf("(%s) %s", contentType, e.Detail)
+		return e
+	}
+	e.message = redactBody(body)
+	var parsed struct {
+		Errors []struct {
+			Code   string `json:"code"`
+			Detail string `json:"detail"`
+		} `json:"errors"`
+	}
+	if json.Unmarshal(body, &parsed) == nil && len(parsed.Errors) > 0 {
+		e.Code, e.Detail = parsed.Errors[0].Code, parsed.Errors[0].Detail
+	}
+	return e
+}
+
+// errorClass says how a failed request should be handled.
+type errorClass int
+
+const (
+	errClassFatal     errorClass = iota // report it
+	errClassRetryable                   // transient: rate limit, gateway error, network hiccup
+	errClassNotFound                    // the object is gone: drop it from state
+	errClassBusy                        // 409 while the server installs, transfers or restores; ends by itself
+	errClassConflict                    // other 409s, e.g. a suspended server, which stays that way
+)
+
+// classify sorts err into an errorClass. The client retries retryable
+// errors, and busy ones with wait_for_ready; resources use the is* helpers
+// below, which are built on it.
+func classify(err error) errorClass {
+	if err == nil {
+		return errClassFatal
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+			return errClassRetryable
+		}
+		return errClassFatal
+	}
+	switch apiErr.StatusCode {
+	case http.StatusNotFound:
+		return errClassNotFound
+	case http.StatusConflict:
+		if strings.Contains(strings.ToLower(apiErr.Code+" "+apiErr.message), "suspended") {
+			return errClassConflict
+		}
+		return errClassBusy
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return errClassRetryable
+	}
+	return errClassFatal
+}
+
+// hasStatus reports whether err is, or wraps, an APIError with one of codes.
+func hasStatus(err error, codes ...int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && slices.Contains(codes, apiErr.StatusCode)
+}
+
+// isNotFound reports whether the panel answered 404.
+func isNotFound(err error) bool { return classify(err) == errClassNotFound }
+
+// isConflict reports whether the panel answered 409, which it does while a
+// server is installing, transferring or suspended.
+func isConflict(err error) bool {
+	c := classify(err)
+	return c == errClassBusy || c == errClassConflict
+}
+
+// isRetryable reports whether trying again later may succeed: transient
+// failures, 404s for objects that are still being created, and busy servers.
+func isRetryable(err error) bool {
+	switch classify(err) {
+	case errClassRetryable, errClassNotFound, errClassBusy:
+		return true
+	}
+	return false
+}
+
+// isRateLimited reports whether the panel answered 429.
+func isRateLimited(err error) bool { return hasStatus(err, http.StatusTooManyRequests) }
+
+// isUnsupported reports whether the panel has no such endpoint (404) or does
+// not allow the method on it (405), as older panels answer for newer calls.
+func isUnsupported(err error) bool {
+	return hasStatus(err, http.StatusNotFound, http.StatusMethodNotAllowed)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+// apiError builds the APIError the client returns for a JSON error answer.
+func apiError(status int, body string) *APIError {
+	resp := &http.Response{StatusCode: status, Header: http.Header{"Content-Type": {"application/json"}}}
+	return newAPIError(resp, []byte(body))
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want errorClass
+	}{
+		{"nil", nil, errClassFatal},
+		{"plain error", errors.New("boom"), errClassFatal},
+		{"response too large", fmt.Errorf("GET /files: %w", errResponseTooLarge), errClassFatal},
+		{"not found", apiError(404, `{"errors":[{"code":"NotFoundHttpException","status":"404","detail":"gone"}]}`), errClassNotFound},
+		{"wrapped not found", fmt.Errorf("fetch server: %w", apiError(404, `{}`)), errClassNotFound},
+		{"installing", apiError(409, `{"errors":[{"code":"ConflictHttpException","status":"409","detail":"This server has not yet completed its installation process, please try again later."}]}`), errClassBusy},
+		{"suspended", apiError(409, `{"errors":[{"code":"ServerStateConflictException","status":"409","detail":"This server is currently suspended and the functionality requested is unavailable."}]}`), errClassConflict},
+		{"rate limited", apiError(429, `{}`), errClassRetryable},
+		{"bad gateway", apiError(502, `{}`), errClassRetryable},
+		{"unavailable", apiError(503, `{}`), errClassRetryable},
+		{"gateway timeout", apiError(504, `{}`), errClassRetryable},
+		{"server error", apiError(500, `{}`), errClassFatal},
+		{"unauthorized", apiError(401, `{}`), errClassFatal},
+		{"validation", apiError(422, `{"errors":[{"code":"ValidationException","status":"422","detail":"The name field is required."}]}`), errClassFatal},
+		{"timeout", &net.DNSError{Err: "i/o timeout", IsTimeout: true}, errClassRetryable},
+		{"connection reset", &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, errClassRetryable},
+		{"connection refused", fmt.Errorf("post: %w", &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}), errClassRetryable},
+		{"unknown host", &net.DNSError{Err: "no such host", IsNotFound: true}, errClassFatal},
+	}
+	for _, tt := range tests {
+		if got := classify(tt.err); got != tt.want {
+			t.Errorf("classify(%s) = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	busy := apiError(409, `{"errors":[{"detail":"installing"}]}`)
+	suspended := apiError(409, `{"errors":[{"detail":"suspended"}]}`)
+	if !isConflict(busy) || !isConflict(suspended) {
+		t.Error("isConflict should hold for every 409")
+	}
+	if !isRetryable(busy) || isRetryable(suspended) {
+		t.Error("isRetryable should hold for a busy server only")
+	}
+	if !isRetryable(apiError(404, `{}`)) || isRetryable(apiError(403, `{}`)) {
+		t.Error("isRetryable should hold for 404 and not for 403")
+	}
+	if !isUnsupported(apiError(405, `{}`)) || isUnsupported(apiError(400, `{}`)) {
+		t.Error("isUnsupported should hold for 405 and not for 400")
+	}
+	if !isRateLimited(fmt.Errorf("list: %w", apiError(429, `{}`))) {
+		t.Error("isRateLimited should see a wrapped 429")
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	resp := &http.Response{StatusCode: 429, Header: http.Header{"Content-Type": {"application/json"}, "Retry-After": {"12"}}}
+	e := newAPIError(resp, []byte(`{"errors":[{"code":"TooManyRequestsHttpException","status":"429","detail":"Too Many Attempts."}]}`))
+	if e.Code != "TooManyRequestsHttpException" || e.Detail != "Too Many Attempts." || e.RetryAfter.Seconds() != 12 {
+		t.Errorf("newAPIError = %+v", e)
+	}
+
+	resp = &http.Response{StatusCode: 502, Header: http.Header{"Content-Type": {"text/html"}}}
+	e = newAPIError(resp, []byte("<html>\n  <body>Bad Gateway</body>\n</html>"))
+	if e.Code != "" || !strings.Contains(e.Error(), "Bad Gateway") || strings.Contains(e.Error(), "\n") {
+		t.Errorf("newAPIError for HTML = %q", e.Error())
+	}
+}
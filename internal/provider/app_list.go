@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Helpers for the paged lists of the Application API, which filter and sort
+// server-side through `filter[<field>]` and `sort` query parameters.
+
+// appListOptions are the filter and sort parameters of a list request.
+type appListOptions struct {
+	Filters map[string]string // filter[<field>]=<value>, a partial match on most panels
+	Sort    string            // field name, "-" prefix for descending
+	Include string            // relationships to include, comma-separated
+}
+
+// appListOptionsFrom reads the `filter` and `sort` attributes of a data source.
+func appListOptionsFrom(filter types.Map, sort types.String) appListOptions {
+	opts := appListOptions{Filters: map[string]string{}, Sort: sort.ValueString()}
+	for k, v := range filter.Elements() {
+		if s, ok := v.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			opts.Filters[k] = s.ValueString()
+		}
+	}
+	return opts
+}
+
+// listAppPages walks every page of the list at path, passing the raw
+// attributes of each item to each.
+func listAppPages(client *Client, path string, opts appListOptions, each func(attributes json.RawMessage) error) error {
+	for page, totalPages := 1, 1; page <= totalPages; page++ {
+		q := url.Values{}
+		q.Set("per_page", "100")
+		q.Set("page", fmt.Sprintf("%d", page))
+		for k, v := range opts.Filters {
+			q.Set("filter["+k+"]", v)
+		}
+		if opts.Sort != "" {
+			q.Set("sort", opts.Sort)
+		}
+		if opts.Include != "" {
+			q.Set("include", opts.Include)
+		}
+
+		body, err := client.Get(path + "?" + q.Encode())
+		if err != nil {
+			return err
+		}
+		var apiResp struct {
+			Data []struct {
+				Attributes json.RawMessage `json:"attributes"`
+			} `json:"data"`
+			Meta struct {
+				Pagination struct {
+					TotalPages int `json:"total_pages"`
+				} `json:"pagination"`
+			} `json:"meta"`
+		}
+		if err := json.Unmarshal(body, &apiResp); err != nil {
+			return fmt.Errorf("JSON parse error: %w", err)
+		}
+		totalPages = apiResp.Meta.Pagination.TotalPages
+
+		for _, item := range apiResp.Data {
+			if err := each(item.Attributes); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// appFilterAttribute is the `filter` option of a list data source accepting
+// the given fields.
+func appFilterAttribute(fields ...string) schema.MapAttribute {
+	return schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.Map{
+			mapvalidator.KeysAre(stringvalidator.OneOf(fields...)),
+		},
+		Description: "Filters applied by the panel, keyed by field: `" + strings.Join(fields, "`, `") + "`. " +
+			"Values match partially, so re-check exact values in a `for` expression where it matters.",
+	}
+}
+
+// appSortAttribute is the `sort` option of a list data source accepting the
+// given fields.
+func appSortAttribute(fields ...string) schema.StringAttribute {
+	values := make([]string, 0, 2*len(fields))
+	for _, f := range fields {
+		values = append(values, f, "-"+f)
+	}
+	return schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(values...),
+		},
+		Description: "Field to sort by, prefixed with `-` for descending: `" + strings.Join(fields, "`, `") + "`. Default: the panel's order.",
+	}
+}
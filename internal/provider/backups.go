@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Helpers around the Client API backup endpoints.
+
+// backupAttributes is a backup as returned by the Client API.
+type backupAttributes struct {
+	UUID         string   `json:"uuid"`
+	Name         string   `json:"name"`
+	IgnoredFiles []string `json:"ignored_files"`
+	Checksum     *string  `json:"checksum"`
+	Bytes        int64    `json:"bytes"`
+	IsSuccessful bool     `json:"is_successful"`
+	IsLocked     bool     `json:"is_locked"`
+	CreatedAt    string   `json:"created_at"`
+	CompletedAt  *string  `json:"completed_at"`
+}
+
+func backupsPath(serverID string) string {
+	return "/servers/" + serverID + "/backups"
+}
+
+// listBackups returns every backup of a server, walking all pages.
+func listBackups(client *Client, serverID string) ([]backupAttributes, error) {
+	var backups []backupAttributes
+	for page, totalPages := 1, 1; page <= totalPages; page++ {
+		q := url.Values{}
+		q.Set("page", fmt.Sprintf("%d", page))
+		body, err := client.Get(backupsPath(serverID) + "?" + q.Encode())
+		if err != nil {
+			return nil, err
+		}
+		var apiResp struct {
+			Data []struct {
+				Attributes backupAttributes `json:"attributes"`
+			} `json:"data"`
+			Meta struct {
+				Pagination struct {
+					TotalPages int `json:"total_pages"`
+				} `json:"pagination"`
+			} `json:"meta"`
+		}
+		if err := json.Unmarshal(body, &apiResp); err != nil {
+			return nil, err
+		}
+		for _, d := range apiResp.Data {
+			backups = append(backups, d.Attributes)
+		}
+		totalPages = apiResp.Meta.Pagination.TotalPages
+	}
+	return backups, nil
+}
+
+// prunableBackups returns the completed, unlocked backups whose name starts
+// with prefix, newest first. Locked and in-progress backups are never pruned.
+func prunableBackups(backups []backupAttributes, prefix string) []backupAttributes {
+	var candidates []backupAttributes
+	for _, b := range backups {
+		if b.IsSuccessful && !b.IsLocked && b.CompletedAt != nil && strings.HasPrefix(b.Name, prefix) {
+			candidates = append(candidates, b)
+		}
+	}
+	// RFC 3339 timestamps in the same zone sort lexically.
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].CreatedAt > candidates[j].CreatedAt })
+	return candidates
+}
+
+// pruneBackupsWithPrefix deletes the oldest completed, unlocked backups whose
+// name starts with prefix so that at most keep of them remain; other backups
+// neither count nor get deleted. It returns the UUIDs of the deleted backups.
+func pruneBackupsWithPrefix(client *Client, serverID string, keep int, prefix string) ([]string, error) {
+	backups, err := listBackups(client, serverID)
+	if err != nil {
+		return nil, err
+	}
+	candidates := prunableBackups(backups, prefix)
+	if len(candidates) <= keep {
+		return nil, nil
+	}
+
+	var deleted []string
+	for _, b := range candidates[keep:] {
+		if err := client.Delete(backupsPath(serverID) + "/" + b.UUID); err != nil {
+			return deleted, fmt.Errorf("delete backup %s: %w", b.UUID, err)
+		}
+		deleted = append(deleted, b.UUID)
+	}
+	return deleted, nil
+}
+
+func backupPath(serverID, uuid string) string {
+	return backupsPath(serverID) + "/" + uuid
+}
+
+// getBackup fetches one backup.
+func getBackup(client *Client, serverID, uuid string) (backupAttributes, error) {
+	body, err := client.Get(backupPath(serverID, uuid))
+	if err != nil {
+		return backupAttributes{}, err
+	}
+	var apiResp struct {
+		Attributes backupAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return backupAttributes{}, err
+	}
+	return apiResp.Attributes, nil
+}
+
+// createBackup starts a backup. ignored holds gitignore-style patterns that
+// are excluded from the archive; an empty name lets the panel pick one.
+func createBackup(client *Client, serverID, name string, ignored []string, locked bool) (backupAttributes, error) {
+	payload := map[string]any{
+		"ignored":   strings.Join(ignored, "\n"),
+		"is_locked": locked,
+	}
+	if name != "" {
+		payload["name"] = name
+	}
+	body, err := client.Post(backupsPath(serverID), payload)
+	if err != nil {
+		return backupAttributes{}, err
+	}
+	var apiResp struct {
+		Attributes backupAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return backupAttributes{}, err
+	}
+	return apiResp.Attributes, nil
+}
+
+// toggleBackupLock flips the lock of a backup; the panel has no "set" call.
+func toggleBackupLock(client *Client, serverID, uuid string) error {
+	_, err := client.Post(backupPath(serverID, uuid)+"/lock", nil)
+	return err
+}
+
+// latestBackup returns the newest backup that completed successfully.
+func latestBackup(backups []backupAttributes) (backupAttributes, bool) {
+	var latest backupAttributes
+	found := false
+	for _, b := range backups {
+		if b.CompletedAt == nil || !b.IsSuccessful {
+			continue
+		}
+		if !found || b.CreatedAt > latest.CreatedAt {
+			latest, found = b, true
+		}
+	}
+	return latest, found
+}
+
+// downloadBackup streams a backup archive into localPath through a signed
+// URL on the node, and returns its size.
+func downloadBackup(ctx context.Context, client *Client, serverID, uuid, localPath string) (int64, error) {
+	body, err := client.Get(backupPath(serverID, uuid) + "/download")
+	if err != nil {
+		return 0, fmt.Errorf("get download URL: %w", err)
+	}
+	var signed struct {
+		Attributes struct {
+			URL string `json:"url"`
+		} `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &signed); err != nil {
+		return 0, err
+	}
+	size, _, err := downloadToFile(ctx, signed.Attributes.URL, localPath)
+	return size, err
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// clientBase is embedded by every resource and data source. It receives the
+// provider's *Client in Configure and checks that the provider talks to the
+// API the type needs.
+type clientBase struct {
+	client   *Client
+	typeName string // full type name, e.g. kineticpanel_server, for diagnostics
+	scope    string // scopeApplication or scopeClient; "" works with either
+}
+
+// configure stores the client from providerData, bound to ctx. The framework
+// configures a new instance for every operation, so ctx is the operation's.
+// kind names the caller in the error, "Resource" or "Data Source".
+func (b *clientBase) configure(ctx context.Context, providerData any, diags *diag.Diagnostics, kind string) {
+	// Nil until the provider itself is configured, e.g. during validation.
+	if providerData == nil {
+		return
+	}
+	client, ok := providerData.(*Client)
+	if !ok {
+		diags.AddError("Unexpected "+kind+" Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", providerData))
+		return
+	}
+	b.client = client.withContext(ctx)
+	if b.scope != "" {
+		requireScope(diags, client, b.typeName, b.scope)
+	}
+}
+
+// resourceBase provides Configure for resources.
+type resourceBase struct {
+	clientBase
+}
+
+func newResourceBase(typeName, scope string) resourceBase {
+	return resourceBase{clientBase{typeName: typeName, scope: scope}}
+}
+
+func (b *resourceBase) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	b.configure(ctx, req.ProviderData, &resp.Diagnostics, "Resource")
+}
+
+// dataSourceBase provides Configure for data sources.
+type dataSourceBase struct {
+	clientBase
+}
+
+func newDataSourceBase(typeName, scope string) dataSourceBase {
+	return dataSourceBase{clientBase{typeName: typeName, scope: scope}}
+}
+
+func (b *dataSourceBase) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	b.configure(ctx, req.ProviderData, &resp.Diagnostics, "Data Source")
+}
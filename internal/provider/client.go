@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -18,6 +19,19 @@ type Client struct {
 	httpClient *http.Client
 	BaseURL    string
 	APIKey     string
+
+	// WaitForReady is how long changes to a busy server are retried; zero
+	// fails them right away. See requestLimited.
+	WaitForReady time.Duration
+
+	// ImageChecker verifies docker images against their registry at plan
+	// time when set (verify_docker_images).
+	ImageChecker *imageChecker
+
+	// ctx is the context of the Terraform operation the client serves, so
+	// Ctrl-C or a provider stop interrupts requests and waits. See
+	// withContext.
+	ctx context.Context
 }
 
 var DebugEnabled = strings.EqualFold(os.Getenv("KINETICPANEL_DEBUG"), "true")
@@ -30,10 +44,15 @@ func NewClient(host, apiKey string, isApplication bool) *Client {
 		base += "/api/client"
 	}
 	c := &Client{
-		httpClient: &http.Client{Timeout: 30 * time.Second},
+		httpClient: &http.Client{Timeout: 30 * time.Second, CheckRedirect: checkRedirect},
 		BaseURL:    base,
 		APIKey:     apiKey,
 	}
+	if vcr, err := vcrFromEnv(); err != nil {
+		c.httpClient.Transport = vcrErrorTransport{err}
+	} else if vcr != nil {
+		c.httpClient.Transport = vcr
+	}
 	if DebugEnabled {
 		tflog.Info(context.Background(), "Client created", map[string]any{
 			"base_url":        c.BaseURL,
@@ -43,9 +62,150 @@ func NewClient(host, apiKey string, isApplication bool) *Client {
 	return c
 }
 
+// withContext returns a copy of c whose requests run under ctx. Resources
+// and data sources are configured anew for every operation, with its context.
+func (c *Client) withContext(ctx context.Context) *Client {
+	cc := *c
+	cc.ctx = ctx
+	return &cc
+}
+
+// context returns the context requests run under.
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// checkRedirect follows redirects within the panel's origin (allowing an
+// upgrade from http to https) and re-applies the API key, which net/http
+// would otherwise drop on some redirects. Anything else fails with a hint to
+// configure the canonical host, instead of silently losing auth or the body.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return errors.New("stopped after 10 redirects")
+	}
+	orig := via[0]
+	sameHost := strings.EqualFold(req.URL.Host, orig.URL.Host)
+	sameScheme := req.URL.Scheme == orig.URL.Scheme || (orig.URL.Scheme == "http" && req.URL.Scheme == "https")
+	if !sameHost || !sameScheme {
+		return fmt.Errorf("panel redirected %s to another origin (%s://%s); set the provider host to the panel's canonical URL",
+			orig.URL.Redacted(), req.URL.Scheme, req.URL.Host)
+	}
+	if req.Method != orig.Method {
+		return fmt.Errorf("panel redirected %s %s to %s, which would turn it into a %s without body; set the provider host to the panel's canonical URL",
+			orig.Method, orig.URL.Redacted(), req.URL.Redacted(), req.Method)
+	}
+	req.Header.Set("Authorization", orig.Header.Get("Authorization"))
+	return nil
+}
+
+// maxResponseBytes caps how much of a response is read into memory. API
+// responses are far smaller; anything larger (e.g. a big file requested
+// through the contents endpoint) fails instead of exhausting memory.
+const maxResponseBytes = 32 << 20
+
+// errResponseTooLarge is wrapped by requests whose response exceeds the cap.
+var errResponseTooLarge = errors.New("response exceeds the size limit")
+
 func (c *Client) request(method, path string, body io.Reader) ([]byte, error) {
+	return c.requestWithContentType(method, path, body, "application/json")
+}
+
+func (c *Client) requestWithContentType(method, path string, body io.Reader, contentType string) ([]byte, error) {
+	return c.requestLimited(c.context(), method, path, body, contentType, maxResponseBytes)
+}
+
+// requestLimited is requestWithContentType with a custom response size cap.
+// Failures classify calls retryable are retried a few times, see
+// sendRetrying. With WaitForReady set, changes to a server through the
+// Client API that the panel rejects with a 409 because the server is
+// installing, transferring or restoring a backup are retried until
+// WaitForReady passes or ctx is cancelled.
+func (c *Client) requestLimited(ctx context.Context, method, path string, body io.Reader, contentType string, limit int64) ([]byte, error) {
+	// The body has to be sent again on every try.
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = io.ReadAll(body); err != nil {
+			return nil, err
+		}
+	}
+	send := func() ([]byte, error) {
+		return c.sendRetrying(ctx, method, path, data, body != nil, contentType, limit)
+	}
+	if c.WaitForReady <= 0 || method == http.MethodGet || !strings.HasSuffix(c.BaseURL, "/api/client") || !strings.HasPrefix(path, "/servers/") {
+		return send()
+	}
+
+	var respBody []byte
+	err := poll(ctx, pollOptions{
+		What:    "server to be ready",
+		Fields:  map[string]any{"method": method, "path": path},
+		Timeout: c.WaitForReady,
+		TimeoutError: func(status string) error {
+			return fmt.Errorf("%s (server still not ready after %s)", status, c.WaitForReady)
+		},
+	}, func() (bool, string, error) {
+		var err error
+		respBody, err = send()
+		if classify(err) == errClassBusy {
+			return false, err.Error(), nil
+		}
+		return true, "", err
+	})
+	return respBody, err
+}
+
+// Retries of failures classify calls retryable: how often, and the backoff
+// before the first retry, which doubles each time. A Retry-After header
+// replaces the backoff, up to maxRetryDelay.
+const (
+	transientRetries = 3
+	transientDelay   = time.Second
+	maxRetryDelay    = 30 * time.Second
+)
+
+// sendRetrying sends a request, retrying failures classify calls retryable.
+// Apart from 429, which the panel answers before doing anything, only GETs
+// are retried: a POST that failed with a 502 may still have been applied.
+func (c *Client) sendRetrying(ctx context.Context, method, path string, data []byte, hasBody bool, contentType string, limit int64) ([]byte, error) {
+	delay := transientDelay
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if hasBody {
+			reqBody = bytes.NewBuffer(data)
+		}
+		respBody, err := c.do(ctx, method, path, reqBody, contentType, limit)
+		if err == nil || attempt >= transientRetries || classify(err) != errClassRetryable {
+			return respBody, err
+		}
+		if method != http.MethodGet && !isRateLimited(err) {
+			return respBody, err
+		}
+
+		wait := delay
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+			wait = min(apiErr.RetryAfter, maxRetryDelay)
+		}
+		tflog.Debug(ctx, "Retrying request", map[string]any{
+			"method": method, "path": path, "attempt": attempt + 1, "wait": wait.String(), "error": err.Error(),
+		})
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		delay *= 2
+	}
+}
+
+// do sends a single request.
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader, contentType string, limit int64) ([]byte, error) {
 	url := fmt.Sprintf("%s%s", c.BaseURL, path)
-	req, err := http.NewRequest(method, url, body)
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +213,7 @@ func (c *Client) request(method, path string, body io.Reader) ([]byte, error) {
 	req.Header.Set("Authorization", "Bearer "+c.APIKey)
 	req.Header.Set("Accept", "application/json")
 	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-Type", contentType)
 	}
 
 	if DebugEnabled {
@@ -68,7 +228,7 @@ func (c *Client) request(method, path string, body io.Reader) ([]byte, error) {
 		})
 		if body != nil {
 			if b, ok := body.(*bytes.Buffer); ok {
-				tflog.Debug(context.Background(), "Request payload", map[string]any{"body": b.String()})
+				tflog.Debug(context.Background(), "Request payload", map[string]any{"body": redactBody(b.Bytes())})
 			}
 		}
 	}
@@ -79,22 +239,46 @@ func (c *Client) request(method, path string, body io.Reader) ([]byte, error) {
 	}
 	defer resp.Body.Close()
 
-	respBody, _ := io.ReadAll(resp.Body)
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if int64(len(respBody)) > limit {
+		return nil, fmt.Errorf("response to %s %s is larger than %d bytes: %w", method, url, limit, errResponseTooLarge)
+	}
 	if DebugEnabled {
 		tflog.Debug(context.Background(), "HTTP response", map[string]any{
 			"status": resp.Status,
-			"body":   string(respBody),
+			"body":   redactBody(respBody),
 		})
 	}
 
+	respType := resp.Header.Get("Content-Type")
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		errMsg := fmt.Sprintf("API error %d: %s", resp.StatusCode, string(respBody))
-		tflog.Error(context.Background(), errMsg)
-		return nil, fmt.Errorf(errMsg)
+		apiErr := newAPIError(resp, respBody)
+		tflog.Error(context.Background(), apiErr.Error())
+		return nil, apiErr
+	}
+
+	// A maintenance page or a host that is not the panel answers with HTML,
+	// which would otherwise surface as "invalid character '<'" in the caller.
+	if strings.Contains(respType, "html") || (strings.Contains(respType, "json") && len(respBody) > 0 && !json.Valid(respBody)) {
+		return nil, fmt.Errorf("unexpected response to %s %s: HTTP %d, Content-Type %q, body: %s. Check that the provider host points at the panel and that it is not in maintenance mode",
+			method, url, resp.StatusCode, respType, bodySnippet(respBody))
 	}
 	return respBody, nil
 }
 
+// bodySnippet returns the start of a response body on one line, for errors.
+func bodySnippet(body []byte) string {
+	const max = 300
+	s := strings.Join(strings.Fields(string(body)), " ")
+	if len(s) > max {
+		s = s[:max] + "…"
+	}
+	if s == "" {
+		return "(empty)"
+	}
+	return s
+}
+
 func (c *Client) Get(path string) ([]byte, error) {
 	return c.request("GET", path, nil)
 }
@@ -110,3 +294,8 @@ func (c *Client) Patch(path string, payload any) ([]byte, error) {
 	return c.request("PATCH", path, bytes.NewBuffer(data))
 }
 func (c *Client) Delete(path string) error { _, err := c.request("DELETE", path, nil); return err }
+
+// PostRaw sends data as-is instead of JSON encoding it (e.g. file contents).
+func (c *Client) PostRaw(path string, data []byte, contentType string) ([]byte, error) {
+	return c.requestWithContentType("POST", path, bytes.NewBuffer(data), contentType)
+}
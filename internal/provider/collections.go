@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Collection policy: computed lists and maps read from the API are always
+// known and empty when the panel returns nothing (missing key, `null` or
+// `[]`). They are never null, so `for_each`/`length()` over them behaves the
+// same whether a server has zero items or the field was omitted.
+
+// nonNil returns values, or an empty slice when it is nil, for typed models
+// where a nil slice would become a null list.
+func nonNil[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
+
+// stringListValue converts a possibly-nil slice into a non-null list of strings.
+func stringListValue(values []string) (types.List, diag.Diagnostics) {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.ListValue(types.StringType, elems)
+}
+
+// stringMapValue converts a possibly-nil map into a non-null map of strings.
+func stringMapValue(values map[string]string) (types.Map, diag.Diagnostics) {
+	elems := make(map[string]attr.Value, len(values))
+	for k, v := range values {
+		elems[k] = types.StringValue(v)
+	}
+	return types.MapValue(types.StringType, elems)
+}
+
+// stringSetValue converts a possibly-nil slice into a non-null set of strings.
+func stringSetValue(values []string) (types.Set, diag.Diagnostics) {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.SetValue(types.StringType, elems)
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// The console is only reachable through the node's websocket: the panel
+// hands out a short-lived token and socket URL, the client authenticates
+// with the token and then receives events such as "console output".
+
+// consoleEvent is a message on the server websocket.
+type consoleEvent struct {
+	Event string   `json:"event"`
+	Args  []string `json:"args,omitempty"`
+}
+
+// consoleCapture is what captureConsole saw.
+type consoleCapture struct {
+	Lines []string // console lines, oldest first, ANSI codes removed
+	Times []string // RFC 3339 time each line was received
+	State string   // last power state announced, "" when none was
+}
+
+// websocketCredentials fetches the token and socket URL for a server.
+func websocketCredentials(client *Client, serverID string) (token, socket string, err error) {
+	body, err := client.Get("/servers/" + serverID + "/websocket")
+	if err != nil {
+		return "", "", err
+	}
+	var apiResp struct {
+		Data struct {
+			Token  string `json:"token"`
+			Socket string `json:"socket"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return "", "", fmt.Errorf("JSON parse error: %w", err)
+	}
+	if apiResp.Data.Token == "" || apiResp.Data.Socket == "" {
+		return "", "", errors.New("panel returned no websocket credentials")
+	}
+	return apiResp.Data.Token, apiResp.Data.Socket, nil
+}
+
+// consoleConn is an authenticated connection to a server websocket.
+type consoleConn struct {
+	ws       *websocket.Conn
+	client   *Client
+	serverID string
+	State    string // last power state announced, "" when none was
+}
+
+// dialConsole connects to the server websocket and returns once the node
+// accepted the token, so no output sent afterwards is missed. With history
+// the node first replays its recent console buffer, which holds the output
+// since the last start.
+func dialConsole(ctx context.Context, client *Client, serverID string, history bool) (*consoleConn, error) {
+	token, socket, err := websocketCredentials(client, serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The node only accepts connections whose Origin is the panel.
+	_, origin := client.apiScope()
+	config, err := websocket.NewConfig(socket, origin)
+	if err != nil {
+		return nil, fmt.Errorf("invalid websocket URL %q: %w", socket, err)
+	}
+	dialCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	ws, err := config.DialContext(dialCtx)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", socket, err)
+	}
+	c := &consoleConn{ws: ws, client: client, serverID: serverID}
+
+	if err := websocket.JSON.Send(ws, consoleEvent{Event: "auth", Args: []string{token}}); err != nil {
+		ws.Close()
+		return nil, fmt.Errorf("send auth: %w", err)
+	}
+	_ = ws.SetReadDeadline(time.Now().Add(30 * time.Second))
+	for {
+		var ev consoleEvent
+		if err := websocket.JSON.Receive(ws, &ev); err != nil {
+			ws.Close()
+			return nil, fmt.Errorf("wait for websocket authentication: %w", err)
+		}
+		if ev.Event == "jwt error" || ev.Event == "daemon error" {
+			ws.Close()
+			return nil, fmt.Errorf("node rejected the websocket: %s", strings.Join(ev.Args, ", "))
+		}
+		if ev.Event == "auth success" {
+			break
+		}
+	}
+	if history {
+		if err := websocket.JSON.Send(ws, consoleEvent{Event: "send logs"}); err != nil {
+			ws.Close()
+			return nil, fmt.Errorf("request console history: %w", err)
+		}
+	}
+	return c, nil
+}
+
+func (c *consoleConn) Close() error {
+	return c.ws.Close()
+}
+
+// read waits until deadline for the next event and returns the console lines
+// it carried, ANSI codes removed; other events return no lines. Reaching the
+// deadline returns an error matching os.ErrDeadlineExceeded.
+func (c *consoleConn) read(deadline time.Time) ([]string, error) {
+	_ = c.ws.SetReadDeadline(deadline)
+	var ev consoleEvent
+	if err := websocket.JSON.Receive(c.ws, &ev); err != nil {
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("read websocket: %w", err)
+	}
+	switch ev.Event {
+	case "token expiring", "token expired":
+		token, _, err := websocketCredentials(c.client, c.serverID)
+		if err != nil {
+			return nil, fmt.Errorf("refresh websocket token: %w", err)
+		}
+		if err := websocket.JSON.Send(c.ws, consoleEvent{Event: "auth", Args: []string{token}}); err != nil {
+			return nil, fmt.Errorf("send auth: %w", err)
+		}
+	case "jwt error", "daemon error":
+		return nil, fmt.Errorf("node rejected the websocket: %s", strings.Join(ev.Args, ", "))
+	case "status":
+		if len(ev.Args) > 0 {
+			c.State = ev.Args[0]
+		}
+	case "console output", "install output", "daemon message":
+		var lines []string
+		for _, arg := range ev.Args {
+			for _, line := range strings.Split(strings.TrimRight(arg, "\r\n"), "\n") {
+				if line = strings.TrimSpace(stripANSI(line)); line != "" {
+					lines = append(lines, line)
+				}
+			}
+		}
+		return lines, nil
+	}
+	return nil, nil
+}
+
+// captureConsole collects console output until wait has passed or maxLines
+// lines arrived (0 for no limit).
+func captureConsole(ctx context.Context, client *Client, serverID string, wait time.Duration, maxLines int, history bool) (consoleCapture, error) {
+	var capture consoleCapture
+	conn, err := dialConsole(ctx, client, serverID, history)
+	if err != nil {
+		return capture, err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(wait)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	for maxLines == 0 || len(capture.Lines) < maxLines {
+		lines, err := conn.read(deadline)
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			break
+		}
+		if err != nil {
+			return capture, err
+		}
+		now := time.Now().UTC().Format(time.RFC3339)
+		for _, line := range lines {
+			capture.Lines = append(capture.Lines, line)
+			capture.Times = append(capture.Times, now)
+		}
+	}
+	if maxLines > 0 && len(capture.Lines) > maxLines {
+		capture.Lines = capture.Lines[:maxLines]
+		capture.Times = capture.Times[:maxLines]
+	}
+	capture.State = conn.State
+	return capture, nil
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// resolveAPIKey returns the API key from the first configured source:
+// api_key, api_key_file, api_key_command, then KINETICPANEL_API_KEY.
+// An empty result without error means no source is configured.
+func resolveAPIKey(ctx context.Context, config kineticpanelProviderModel) (string, error) {
+	if key := config.APIKey.ValueString(); key != "" {
+		return key, nil
+	}
+
+	if file := config.APIKeyFile.ValueString(); file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("read api_key_file: %w", err)
+		}
+		key := strings.TrimSpace(string(data))
+		if key == "" {
+			return "", fmt.Errorf("api_key_file %s is empty", file)
+		}
+		return key, nil
+	}
+
+	if !config.APIKeyCommand.IsNull() && !config.APIKeyCommand.IsUnknown() {
+		var argv []string
+		if diags := config.APIKeyCommand.ElementsAs(ctx, &argv, false); diags.HasError() || len(argv) == 0 {
+			return "", fmt.Errorf("api_key_command must list a command and its arguments")
+		}
+		return runCredentialHelper(ctx, argv)
+	}
+
+	return os.Getenv("KINETICPANEL_API_KEY"), nil
+}
+
+// runCredentialHelper runs argv and returns its trimmed stdout. Stderr is
+// only included in errors, never logged.
+func runCredentialHelper(ctx context.Context, argv []string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, argv[0], argv[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("api_key_command %s failed: %v: %s", argv[0], err, strings.TrimSpace(stderr.String()))
+	}
+	key := strings.TrimSpace(stdout.String())
+	if key == "" {
+		return "", fmt.Errorf("api_key_command %s printed nothing", argv[0])
+	}
+	return key, nil
+}
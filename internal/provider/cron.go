@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedules use standard five field cron syntax: `*`, values, ranges
+// (`1-5`), steps (`*/15`, `0-30/10`) and lists of those, plus month and
+// weekday names (`jan`, `mon`). Day of week 7 is Sunday like 0. As in the
+// panel, a day matches when day of month OR day of week matches, unless one
+// of them is `*`.
+
+// cronField describes one field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // names of min, min+1, ...; nil when the field has none
+}
+
+var cronFields = [5]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+// cronSchedule is a parsed cron expression; each field is a bitset of the
+// values it matches.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+// parseCron parses the five fields of a cron expression.
+func parseCron(minute, hour, dom, month, dow string) (cronSchedule, error) {
+	var sets [5]uint64
+	for i, expr := range []string{minute, hour, dom, month, dow} {
+		set, err := cronFields[i].parse(expr)
+		if err != nil {
+			return cronSchedule{}, err
+		}
+		sets[i] = set
+	}
+	// Sunday is both 0 and 7.
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+	}
+	return cronSchedule{
+		minute: sets[0], hour: sets[1], dom: sets[2], month: sets[3], dow: sets[4],
+		domAny: strings.TrimSpace(dom) == "*",
+		dowAny: strings.TrimSpace(dow) == "*",
+	}, nil
+}
+
+// parse returns the values a field expression matches.
+func (f cronField) parse(expr string) (uint64, error) {
+	expr = strings.ToLower(strings.TrimSpace(expr))
+	if expr == "" {
+		return 0, fmt.Errorf("%s is empty", f.name)
+	}
+	var set uint64
+	for _, part := range strings.Split(expr, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepStr)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("%s %q: step %q must be a positive number", f.name, expr, stepStr)
+			}
+			step = n
+		}
+
+		lo, hi := f.min, f.max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			a, b, _ := strings.Cut(rng, "-")
+			var err error
+			if lo, err = f.value(a); err != nil {
+				return 0, fmt.Errorf("%s %q: %w", f.name, expr, err)
+			}
+			if hi, err = f.value(b); err != nil {
+				return 0, fmt.Errorf("%s %q: %w", f.name, expr, err)
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("%s %q: range %s runs backwards", f.name, expr, rng)
+			}
+		default:
+			v, err := f.value(rng)
+			if err != nil {
+				return 0, fmt.Errorf("%s %q: %w", f.name, expr, err)
+			}
+			lo = v
+			if !hasStep {
+				hi = v
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+// value parses a number or name within the field's bounds.
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if s == name {
+			return f.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%d is outside %d-%d", n, f.min, f.max)
+	}
+	return n, nil
+}
+
+// matchesDay reports whether the schedule runs on t's day.
+func (c cronSchedule) matchesDay(t time.Time) bool {
+	dom := c.dom&(1<<t.Day()) != 0
+	dow := c.dow&(1<<int(t.Weekday())) != 0
+	if c.domAny || c.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// next returns the first run strictly after t, in t's location, or false
+// when there is none within five years (e.g. `0 0 31 2 *`).
+func (c cronSchedule) next(t time.Time) (time.Time, bool) {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if c.month&(1<<int(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.hour&(1<<t.Hour()) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.minute&(1<<t.Minute()) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
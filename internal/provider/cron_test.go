@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr string // substring of the error; empty for a valid expression
+	}{
+		{expr: "* * * * *"},
+		{expr: "0 4 * * *"},
+		{expr: "*/15 0-6 1,15 jan-jun mon-fri"},
+		{expr: "0-30/10 5/2 * * 7"},
+		{expr: "0 0 * DEC SUN"},
+		{expr: "60 * * * *", wantErr: "minute"},
+		{expr: "* 24 * * *", wantErr: "hour"},
+		{expr: "* * 0 * *", wantErr: "day of month"},
+		{expr: "* * * 13 *", wantErr: "month"},
+		{expr: "* * * * 8", wantErr: "day of week"},
+		{expr: "*/0 * * * *", wantErr: "step"},
+		{expr: "*/x * * * *", wantErr: "step"},
+		{expr: "30-10 * * * *", wantErr: "backwards"},
+		{expr: "1- * * * *", wantErr: "not a number"},
+		{expr: "* * * foo *", wantErr: "not a number"},
+		{expr: "* * *  * ", wantErr: "empty"},
+	}
+	for _, tt := range tests {
+		f := strings.Split(tt.expr, " ")
+		_, err := parseCron(f[0], f[1], f[2], f[3], f[4])
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("parseCron(%q): %v", tt.expr, err)
+		case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+			t.Errorf("parseCron(%q) error = %v, want one mentioning %q", tt.expr, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	tests := []struct {
+		expr string
+		from string
+		want []string // following runs; empty when there are none
+	}{
+		{"0 4 * * *", "2024-05-01T03:00:00Z", []string{"2024-05-01T04:00:00Z", "2024-05-02T04:00:00Z"}},
+		{"0 4 * * *", "2024-05-01T04:00:00Z", []string{"2024-05-02T04:00:00Z"}},
+		{"0 4 * * *", "2024-05-01T03:59:30Z", []string{"2024-05-01T04:00:00Z"}},
+		{"*/15 * * * *", "2024-05-01T10:07:00Z", []string{"2024-05-01T10:15:00Z", "2024-05-01T10:30:00Z", "2024-05-01T10:45:00Z", "2024-05-01T11:00:00Z"}},
+		{"0-30/10 12 * * *", "2024-05-01T12:25:00Z", []string{"2024-05-01T12:30:00Z", "2024-05-02T12:00:00Z"}},
+		// Sunday as 7; 2024-05-01 is a Wednesday.
+		{"0 0 * * 7", "2024-05-01T00:00:00Z", []string{"2024-05-05T00:00:00Z", "2024-05-12T00:00:00Z"}},
+		{"0 9 * * mon-fri", "2024-05-03T10:00:00Z", []string{"2024-05-06T09:00:00Z"}},
+		// Day of month OR day of week: the 13th, or any Friday.
+		{"0 0 13 * fri", "2024-09-01T00:00:00Z", []string{"2024-09-06T00:00:00Z", "2024-09-13T00:00:00Z", "2024-09-20T00:00:00Z"}},
+		{"0 0 1 jan *", "2024-05-01T00:00:00Z", []string{"2025-01-01T00:00:00Z"}},
+		{"0 0 29 2 *", "2024-03-01T00:00:00Z", []string{"2028-02-29T00:00:00Z"}},
+		{"0 0 31 2 *", "2024-01-01T00:00:00Z", nil},
+	}
+	for _, tt := range tests {
+		f := strings.Split(tt.expr, " ")
+		schedule, err := parseCron(f[0], f[1], f[2], f[3], f[4])
+		if err != nil {
+			t.Errorf("parseCron(%q): %v", tt.expr, err)
+			continue
+		}
+		from, err := time.Parse(time.RFC3339, tt.from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		at := from
+		for i, want := range tt.want {
+			next, ok := schedule.next(at)
+			if !ok || next.Format(time.RFC3339) != want {
+				t.Errorf("%q from %s: run %d = %s (found %v), want %s", tt.expr, tt.from, i+1, next.Format(time.RFC3339), ok, want)
+				break
+			}
+			at = next
+		}
+		if len(tt.want) == 0 {
+			if next, ok := schedule.next(from); ok {
+				t.Errorf("%q from %s: got run %s, want none", tt.expr, tt.from, next.Format(time.RFC3339))
+			}
+		}
+	}
+}
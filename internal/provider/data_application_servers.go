@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ApplicationServersDataSource{}
+
+// ApplicationServersDataSource lists every server on the panel, filtered and
+// sorted by the panel.
+type ApplicationServersDataSource struct {
+	dataSourceBase
+}
+
+// applicationServersModel holds the data source state.
+type applicationServersModel struct {
+	Filter  types.Map    `tfsdk:"filter"`
+	Sort    types.String `tfsdk:"sort"`
+	Servers types.List   `tfsdk:"servers"`
+}
+
+// appServerSummary is the part of an Application API server the list exposes.
+type appServerSummary struct {
+	ID          int64   `json:"id"`
+	ExternalID  *string `json:"external_id"`
+	UUID        string  `json:"uuid"`
+	Identifier  string  `json:"identifier"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Suspended   bool    `json:"suspended"`
+	User        int64   `json:"user"`
+	Node        int64   `json:"node"`
+	Allocation  int64   `json:"allocation"`
+	Nest        int64   `json:"nest"`
+	Egg         int64   `json:"egg"`
+	Container   struct {
+		Image string `json:"image"`
+	} `json:"container"`
+}
+
+var applicationServerAttrTypes = map[string]attr.Type{
+	"id":            types.Int64Type,
+	"external_id":   types.StringType,
+	"uuid":          types.StringType,
+	"identifier":    types.StringType,
+	"name":          types.StringType,
+	"description":   types.StringType,
+	"suspended":     types.BoolType,
+	"user_id":       types.Int64Type,
+	"node_id":       types.Int64Type,
+	"allocation_id": types.Int64Type,
+	"nest_id":       types.Int64Type,
+	"egg_id":        types.Int64Type,
+	"docker_image":  types.StringType,
+}
+
+func NewApplicationServersDataSource() datasource.DataSource {
+	return &ApplicationServersDataSource{dataSourceBase: newDataSourceBase("kineticpanel_application_servers", scopeApplication)}
+}
+
+func (d *ApplicationServersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_servers"
+}
+
+func (d *ApplicationServersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every server on the panel (Application API), unlike `kineticpanel_servers` which only sees the servers of the key's user. " +
+			"`filter` and `sort` are applied by the panel, so lookups by uuid, name or image do not download every page.",
+		Attributes: map[string]schema.Attribute{
+			"filter": appFilterAttribute("uuid", "uuidShort", "name", "description", "image", "external_id"),
+			"sort":   appSortAttribute("id", "uuid"),
+			"servers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Servers matching `filter`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":            schema.Int64Attribute{Computed: true},
+						"external_id":   schema.StringAttribute{Computed: true},
+						"uuid":          schema.StringAttribute{Computed: true},
+						"identifier":    schema.StringAttribute{Computed: true},
+						"name":          schema.StringAttribute{Computed: true},
+						"description":   schema.StringAttribute{Computed: true},
+						"suspended":     schema.BoolAttribute{Computed: true},
+						"user_id":       schema.Int64Attribute{Computed: true},
+						"node_id":       schema.Int64Attribute{Computed: true},
+						"allocation_id": schema.Int64Attribute{Computed: true},
+						"nest_id":       schema.Int64Attribute{Computed: true},
+						"egg_id":        schema.Int64Attribute{Computed: true},
+						"docker_image":  schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationServersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config applicationServersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	servers := []attr.Value{}
+	err := listAppPages(d.client, "/servers", appListOptionsFrom(config.Filter, config.Sort), func(raw json.RawMessage) error {
+		var s appServerSummary
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return fmt.Errorf("JSON parse error: %w", err)
+		}
+		obj, diags := types.ObjectValue(applicationServerAttrTypes, map[string]attr.Value{
+			"id":            types.Int64Value(s.ID),
+			"external_id":   types.StringPointerValue(s.ExternalID),
+			"uuid":          types.StringValue(s.UUID),
+			"identifier":    types.StringValue(s.Identifier),
+			"name":          types.StringValue(s.Name),
+			"description":   types.StringValue(s.Description),
+			"suspended":     types.BoolValue(s.Suspended),
+			"user_id":       types.Int64Value(s.User),
+			"node_id":       types.Int64Value(s.Node),
+			"allocation_id": types.Int64Value(s.Allocation),
+			"nest_id":       types.Int64Value(s.Nest),
+			"egg_id":        types.Int64Value(s.Egg),
+			"docker_image":  types.StringValue(s.Container.Image),
+		})
+		resp.Diagnostics.Append(diags...)
+		servers = append(servers, obj)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list servers: %v", err))
+		return
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: applicationServerAttrTypes}, servers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Servers = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
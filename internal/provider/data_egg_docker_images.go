@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EggDockerImagesDataSource{}
+
+// EggDockerImagesDataSource lists the docker images an egg allows.
+type EggDockerImagesDataSource struct {
+	dataSourceBase
+}
+
+// eggDockerImagesModel holds the data source state.
+type eggDockerImagesModel struct {
+	NestID       types.Int64  `tfsdk:"nest_id"`
+	EggID        types.Int64  `tfsdk:"egg_id"`
+	DockerImages types.Map    `tfsdk:"docker_images"` // display name -> image
+	Images       types.List   `tfsdk:"images"`
+	DefaultImage types.String `tfsdk:"default_image"`
+}
+
+func NewEggDockerImagesDataSource() datasource.DataSource {
+	return &EggDockerImagesDataSource{dataSourceBase: newDataSourceBase("kineticpanel_egg_docker_images", scopeApplication)}
+}
+
+func (d *EggDockerImagesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_egg_docker_images"
+}
+
+func (d *EggDockerImagesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the docker images an egg allows (Application API), e.g. to pick or validate a `docker_image`.",
+		Attributes: map[string]schema.Attribute{
+			"nest_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Nest that contains the egg. When omitted, all nests are searched.",
+			},
+			"egg_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Egg ID.",
+			},
+			"docker_images": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Allowed images keyed by their display name (e.g. `Java 17`).",
+			},
+			"images": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Allowed images, sorted.",
+			},
+			"default_image": schema.StringAttribute{
+				Computed:    true,
+				Description: "Image the panel uses when none is chosen.",
+			},
+		},
+	}
+}
+
+func (d *EggDockerImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config eggDockerImagesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eggID := config.EggID.ValueInt64()
+	var egg eggAttributes
+	var err error
+	if config.NestID.IsNull() {
+		egg, err = findEgg(d.client, eggID)
+	} else {
+		egg, err = getEgg(d.client, config.NestID.ValueInt64(), eggID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch egg %d: %v", eggID, err))
+		return
+	}
+
+	allowed := egg.allowedImages()
+	images := make([]string, 0, len(allowed))
+	for _, img := range allowed {
+		images = append(images, img)
+	}
+	sort.Strings(images)
+
+	imageMap, diags := stringMapValue(allowed)
+	resp.Diagnostics.Append(diags...)
+	imageList, diags := stringListValue(images)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.NestID = types.Int64Value(egg.Nest)
+	config.DockerImages = imageMap
+	config.Images = imageList
+	config.DefaultImage = types.StringValue(egg.defaultImage())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
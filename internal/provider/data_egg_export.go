@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EggExportDataSource{}
+
+// EggExportDataSource exports an egg in the panel's egg file format.
+type EggExportDataSource struct {
+	dataSourceBase
+}
+
+// eggExportModel holds the data source state.
+type eggExportModel struct {
+	NestID types.Int64  `tfsdk:"nest_id"`
+	EggID  types.Int64  `tfsdk:"egg_id"`
+	JSON   types.String `tfsdk:"json"`
+	Name   types.String `tfsdk:"name"`
+	Source types.String `tfsdk:"source"`
+}
+
+func NewEggExportDataSource() datasource.DataSource {
+	return &EggExportDataSource{dataSourceBase: newDataSourceBase("kineticpanel_egg_export", scopeApplication)}
+}
+
+func (d *EggExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_egg_export"
+}
+
+func (d *EggExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exports an egg as the JSON file the panel imports (Application API), e.g. to back it up with `local_file` or mirror it to another panel. " +
+			"Panels with an egg export endpoint return their own export; otherwise the file is assembled from the egg, its install script and its variables in the `PTDL_v2` format.",
+		Attributes: map[string]schema.Attribute{
+			"nest_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Nest that contains the egg. When omitted, all nests are searched.",
+			},
+			"egg_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Egg ID.",
+			},
+			"json": schema.StringAttribute{
+				Computed:    true,
+				Description: "The egg file, indented JSON.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Egg name, e.g. for the file name.",
+			},
+			"source": schema.StringAttribute{
+				Computed:    true,
+				Description: "`export` when the panel exported the egg itself, `api` when it was assembled from the Application API.",
+			},
+		},
+	}
+}
+
+// eggScript is the install script part of an egg (`include=script` is not
+// needed; the Application API always returns it).
+type eggScript struct {
+	Privileged bool   `json:"privileged"`
+	Install    string `json:"install"`
+	Entry      string `json:"entry"`
+	Container  string `json:"container"`
+}
+
+// eggConfig is the process configuration of an egg. files, startup and logs
+// are objects in the API but JSON strings in egg files.
+type eggConfig struct {
+	Files        json.RawMessage `json:"files"`
+	Startup      json.RawMessage `json:"startup"`
+	Stop         string          `json:"stop"`
+	Logs         json.RawMessage `json:"logs"`
+	FileDenylist []string        `json:"file_denylist"`
+}
+
+// eggExportAttributes is the part of an egg the export needs beyond eggAttributes.
+type eggExportAttributes struct {
+	Features []string  `json:"features"`
+	Config   eggConfig `json:"config"`
+	Script   eggScript `json:"script"`
+}
+
+// exportEgg returns the egg file of an egg and whether the panel exported it
+// itself. Pelican-based panels serve /eggs/{id}/export; Pterodactyl does not,
+// so the file is assembled from the egg there.
+func exportEgg(client *Client, egg eggAttributes) ([]byte, string, error) {
+	body, err := client.Get(fmt.Sprintf("/eggs/%d/export?format=json", egg.ID))
+	if err == nil && json.Valid(body) {
+		var out bytes.Buffer
+		if err := json.Indent(&out, body, "", "    "); err != nil {
+			return nil, "", err
+		}
+		return out.Bytes(), "export", nil
+	}
+	if err != nil && !isUnsupported(err) {
+		return nil, "", err
+	}
+
+	body, err = client.Get(fmt.Sprintf("/nests/%d/eggs/%d?include=variables", egg.Nest, egg.ID))
+	if err != nil {
+		return nil, "", err
+	}
+	var apiResp struct {
+		Attributes eggExportAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, "", fmt.Errorf("JSON parse error: %w", err)
+	}
+	extra := apiResp.Attributes
+
+	// configString renders a config object the way egg files store it.
+	configString := func(raw json.RawMessage) string {
+		if len(raw) == 0 || string(raw) == "null" || string(raw) == "[]" {
+			return "{}"
+		}
+		return string(raw)
+	}
+
+	variables := []map[string]any{}
+	for _, v := range egg.variables() {
+		def := ""
+		if v.DefaultValue != nil {
+			def = *v.DefaultValue
+		}
+		variables = append(variables, map[string]any{
+			"name":          v.Name,
+			"description":   v.Description,
+			"env_variable":  v.EnvVariable,
+			"default_value": def,
+			"user_viewable": v.UserViewable,
+			"user_editable": v.UserEditable,
+			"rules":         v.Rules,
+			"field_type":    "text",
+		})
+	}
+	dockerImages := egg.allowedImages()
+	features := extra.Features
+	if features == nil {
+		features = []string{}
+	}
+	denylist := extra.Config.FileDenylist
+	if denylist == nil {
+		denylist = []string{}
+	}
+
+	file := map[string]any{
+		"_comment": "Exported from the Application API by the Kinetic Panel Terraform provider",
+		"meta": map[string]any{
+			"version":    "PTDL_v2",
+			"update_url": nil,
+		},
+		"name":          egg.Name,
+		"author":        egg.Author,
+		"description":   egg.Description,
+		"features":      features,
+		"docker_images": dockerImages,
+		"file_denylist": denylist,
+		"startup":       egg.Startup,
+		"config": map[string]any{
+			"files":   configString(extra.Config.Files),
+			"startup": configString(extra.Config.Startup),
+			"logs":    configString(extra.Config.Logs),
+			"stop":    extra.Config.Stop,
+		},
+		"scripts": map[string]any{
+			"installation": map[string]any{
+				"script":     extra.Script.Install,
+				"container":  extra.Script.Container,
+				"entrypoint": extra.Script.Entry,
+			},
+		},
+		"variables": variables,
+	}
+	out, err := json.MarshalIndent(file, "", "    ")
+	if err != nil {
+		return nil, "", err
+	}
+	return out, "api", nil
+}
+
+func (d *EggExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config eggExportModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eggID := config.EggID.ValueInt64()
+	var egg eggAttributes
+	var err error
+	if config.NestID.IsNull() {
+		egg, err = findEgg(d.client, eggID)
+		if err == nil {
+			// findEgg does not include variables.
+			egg, err = getEgg(d.client, egg.Nest, eggID)
+		}
+	} else {
+		egg, err = getEgg(d.client, config.NestID.ValueInt64(), eggID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch egg %d: %v", eggID, err))
+		return
+	}
+
+	file, source, err := exportEgg(d.client, egg)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to export egg %d: %v", eggID, err))
+		return
+	}
+
+	config.NestID = types.Int64Value(egg.Nest)
+	config.JSON = types.StringValue(string(file))
+	config.Name = types.StringValue(egg.Name)
+	config.Source = types.StringValue(source)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
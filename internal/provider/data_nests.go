@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NestsDataSource{}
+
+// NestsDataSource lists every nest with its eggs.
+type NestsDataSource struct {
+	dataSourceBase
+}
+
+// nestsModel holds the data source state.
+type nestsModel struct {
+	Nests types.List `tfsdk:"nests"`
+}
+
+var nestEggAttrTypes = map[string]attr.Type{
+	"id":            types.Int64Type,
+	"uuid":          types.StringType,
+	"name":          types.StringType,
+	"description":   types.StringType,
+	"author":        types.StringType,
+	"docker_image":  types.StringType,
+	"docker_images": types.MapType{ElemType: types.StringType},
+	"startup":       types.StringType,
+}
+
+var nestAttrTypes = map[string]attr.Type{
+	"id":          types.Int64Type,
+	"uuid":        types.StringType,
+	"name":        types.StringType,
+	"description": types.StringType,
+	"author":      types.StringType,
+	"eggs":        types.ListType{ElemType: types.ObjectType{AttrTypes: nestEggAttrTypes}},
+}
+
+func NewNestsDataSource() datasource.DataSource {
+	return &NestsDataSource{dataSourceBase: newDataSourceBase("kineticpanel_nests", scopeApplication)}
+}
+
+func (d *NestsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nests"
+}
+
+func (d *NestsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	eggAttributes := map[string]schema.Attribute{
+		"id":           schema.Int64Attribute{Computed: true},
+		"uuid":         schema.StringAttribute{Computed: true},
+		"name":         schema.StringAttribute{Computed: true},
+		"description":  schema.StringAttribute{Computed: true},
+		"author":       schema.StringAttribute{Computed: true},
+		"docker_image": schema.StringAttribute{Computed: true, Description: "Default image."},
+		"docker_images": schema.MapAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "Allowed images, keyed by display name.",
+		},
+		"startup": schema.StringAttribute{Computed: true, Description: "Default startup command."},
+	}
+	resp.Schema = schema.Schema{
+		Description: "Lists every nest with its eggs (Application API) in one read, e.g. to offer a choice of games in a module or to look an egg up by name: " +
+			"`{ for n in data.kineticpanel_nests.all.nests : n.name => { for e in n.eggs : e.name => e.id } }`. " +
+			"Nests and eggs are sorted by ID. Egg variables are not included; see `kineticpanel_egg_export`.",
+		Attributes: map[string]schema.Attribute{
+			"nests": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":          schema.Int64Attribute{Computed: true},
+						"uuid":        schema.StringAttribute{Computed: true},
+						"name":        schema.StringAttribute{Computed: true},
+						"description": schema.StringAttribute{Computed: true},
+						"author":      schema.StringAttribute{Computed: true},
+						"eggs": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: eggAttributes,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NestsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config nestsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nests, err := listNests(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list nests: %v", err))
+		return
+	}
+	sort.Slice(nests, func(i, j int) bool { return nests[i].ID < nests[j].ID })
+
+	nestValues := make([]attr.Value, 0, len(nests))
+	for _, n := range nests {
+		eggs := n.eggs()
+		sort.Slice(eggs, func(i, j int) bool { return eggs[i].ID < eggs[j].ID })
+		eggValues := make([]attr.Value, 0, len(eggs))
+		for _, e := range eggs {
+			images, diags := stringMapValue(e.allowedImages())
+			resp.Diagnostics.Append(diags...)
+			obj, diags := types.ObjectValue(nestEggAttrTypes, map[string]attr.Value{
+				"id":            types.Int64Value(e.ID),
+				"uuid":          types.StringValue(e.UUID),
+				"name":          types.StringValue(e.Name),
+				"description":   types.StringValue(e.Description),
+				"author":        types.StringValue(e.Author),
+				"docker_image":  types.StringValue(e.defaultImage()),
+				"docker_images": images,
+				"startup":       types.StringValue(e.Startup),
+			})
+			resp.Diagnostics.Append(diags...)
+			eggValues = append(eggValues, obj)
+		}
+		eggList, diags := types.ListValue(types.ObjectType{AttrTypes: nestEggAttrTypes}, eggValues)
+		resp.Diagnostics.Append(diags...)
+
+		obj, diags := types.ObjectValue(nestAttrTypes, map[string]attr.Value{
+			"id":          types.Int64Value(n.ID),
+			"uuid":        types.StringValue(n.UUID),
+			"name":        types.StringValue(n.Name),
+			"description": types.StringValue(n.Description),
+			"author":      types.StringValue(n.Author),
+			"eggs":        eggList,
+		})
+		resp.Diagnostics.Append(diags...)
+		nestValues = append(nestValues, obj)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: nestAttrTypes}, nestValues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Nests = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
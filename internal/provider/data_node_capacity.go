@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NodeCapacityDataSource{}
+
+// NodeCapacityDataSource reports how much of a node's memory and disk is allocated to servers.
+type NodeCapacityDataSource struct {
+	dataSourceBase
+}
+
+// nodeCapacityModel holds the data source state.
+type nodeCapacityModel struct {
+	NodeID          types.Int64  `tfsdk:"node_id"`
+	Name            types.String `tfsdk:"name"`
+	MaintenanceMode types.Bool   `tfsdk:"maintenance_mode"`
+	Memory          types.Int64  `tfsdk:"memory"`
+	Disk            types.Int64  `tfsdk:"disk"`
+	AllocatedMemory types.Int64  `tfsdk:"allocated_memory"`
+	AllocatedDisk   types.Int64  `tfsdk:"allocated_disk"`
+	FreeMemory      types.Int64  `tfsdk:"free_memory"`
+	FreeDisk        types.Int64  `tfsdk:"free_disk"`
+	ServerCount     types.Int64  `tfsdk:"server_count"`
+}
+
+func NewNodeCapacityDataSource() datasource.DataSource {
+	return &NodeCapacityDataSource{dataSourceBase: newDataSourceBase("kineticpanel_node_capacity", scopeApplication)}
+}
+
+func (d *NodeCapacityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_capacity"
+}
+
+func (d *NodeCapacityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports a node's total and allocated memory and disk, and its server count (Application API). " +
+			"Allocated values are the sum of the limits of the node's servers, so placement modules can pick the least-loaded node.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Node ID.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Node name.",
+			},
+			"maintenance_mode": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the node is in maintenance mode.",
+			},
+			"memory": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Total memory in MiB, including the node's memory overallocation.",
+			},
+			"disk": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Total disk in MiB, including the node's disk overallocation.",
+			},
+			"allocated_memory": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Memory in MiB allocated to servers on the node.",
+			},
+			"allocated_disk": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Disk in MiB allocated to servers on the node.",
+			},
+			"free_memory": schema.Int64Attribute{
+				Computed:    true,
+				Description: "`memory` minus `allocated_memory`. Negative when the node is overcommitted.",
+			},
+			"free_disk": schema.Int64Attribute{
+				Computed:    true,
+				Description: "`disk` minus `allocated_disk`. Negative when the node is overcommitted.",
+			},
+			"server_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of servers on the node.",
+			},
+		},
+	}
+}
+
+func (d *NodeCapacityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config nodeCapacityModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeID := config.NodeID.ValueInt64()
+	node, err := getNode(d.client, nodeID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch node %d: %v", nodeID, err))
+		return
+	}
+
+	memory := withOverallocation(node.Memory, node.MemoryOverallocate)
+	disk := withOverallocation(node.Disk, node.DiskOverallocate)
+	allocatedMemory, allocatedDisk := node.allocated()
+
+	config.Name = types.StringValue(node.Name)
+	config.MaintenanceMode = types.BoolValue(node.MaintenanceMode)
+	config.Memory = types.Int64Value(memory)
+	config.Disk = types.Int64Value(disk)
+	config.AllocatedMemory = types.Int64Value(allocatedMemory)
+	config.AllocatedDisk = types.Int64Value(allocatedDisk)
+	config.FreeMemory = types.Int64Value(memory - allocatedMemory)
+	config.FreeDisk = types.Int64Value(disk - allocatedDisk)
+	config.ServerCount = types.Int64Value(int64(len(node.Relationships.Servers.Data)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
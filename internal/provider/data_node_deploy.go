@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NodeDeployDataSource{}
+
+// NodeDeployDataSource exposes what a new node VM needs to run Wings.
+type NodeDeployDataSource struct {
+	dataSourceBase
+}
+
+// nodeDeployModel holds the data source state.
+type nodeDeployModel struct {
+	NodeID        types.Int64  `tfsdk:"node_id"`
+	DeployAPIKey  types.String `tfsdk:"deploy_api_key"`
+	PanelURL      types.String `tfsdk:"panel_url"`
+	Configuration types.String `tfsdk:"configuration"`
+	DeployCommand types.String `tfsdk:"deploy_command"`
+}
+
+// wingsConfigPath is where Wings reads its configuration.
+const wingsConfigPath = "/etc/pterodactyl/config.yml"
+
+func NewNodeDeployDataSource() datasource.DataSource {
+	return &NodeDeployDataSource{dataSourceBase: newDataSourceBase("kineticpanel_node_deploy", scopeApplication)}
+}
+
+func (d *NodeDeployDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_deploy"
+}
+
+func (d *NodeDeployDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exposes what the VM of a node needs to run Wings (Application API), for cloud-init or `user_data`: " +
+			"`configuration`, the node's Wings configuration to write to `" + wingsConfigPath + "`, and optionally the panel's auto-deploy command. " +
+			"Both contain credentials and are stored in the Terraform state; treat the state accordingly.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Node ID.",
+			},
+			"deploy_api_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Application API key with read access to nodes for `wings configure`, as the panel's auto-deploy button creates. " +
+					"Create a dedicated key rather than reusing the provider's. Without it `deploy_command` is null.",
+			},
+			"panel_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the panel the daemon reports to.",
+			},
+			"configuration": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The node's Wings configuration as JSON, which Wings reads as YAML. Includes the daemon token.",
+			},
+			"deploy_command": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Shell command that fetches the configuration with `wings configure`, as shown by the panel's auto-deploy.",
+			},
+		},
+	}
+}
+
+// shellQuote quotes s for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func (d *NodeDeployDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config nodeDeployModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployKey := config.DeployAPIKey.ValueString()
+	if got := keyScope(deployKey); deployKey != "" && got == scopeClient {
+		resp.Diagnostics.AddAttributeError(path.Root("deploy_api_key"), "Wrong API Key Type",
+			"wings configure needs an Application API key with read access to nodes; this looks like a Client API key.")
+		return
+	}
+
+	nodeID := config.NodeID.ValueInt64()
+	body, err := d.client.Get(fmt.Sprintf("/nodes/%d/configuration", nodeID))
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch configuration of node %d: %v", nodeID, err))
+		return
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, body, "", "  "); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch configuration of node %d: JSON parse error: %v", nodeID, err))
+		return
+	}
+
+	_, panelURL := d.client.apiScope()
+	config.PanelURL = types.StringValue(panelURL)
+	config.Configuration = types.StringValue(out.String())
+	config.DeployCommand = types.StringNull()
+	if deployKey != "" {
+		config.DeployCommand = types.StringValue(fmt.Sprintf("cd /etc/pterodactyl && sudo wings configure --panel-url %s --token %s --node %d",
+			shellQuote(panelURL), shellQuote(deployKey), nodeID))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NodeSystemInfoDataSource{}
+
+// NodeSystemInfoDataSource reports the daemon version and host details of a node.
+type NodeSystemInfoDataSource struct {
+	dataSourceBase
+}
+
+// nodeSystemInfoModel holds the data source state.
+type nodeSystemInfoModel struct {
+	NodeID         types.Int64  `tfsdk:"node_id"`
+	Source         types.String `tfsdk:"source"`
+	MinimumVersion types.String `tfsdk:"minimum_version"`
+	WingsVersion   types.String `tfsdk:"wings_version"`
+	Outdated       types.Bool   `tfsdk:"outdated"`
+	DockerVersion  types.String `tfsdk:"docker_version"`
+	Architecture   types.String `tfsdk:"architecture"`
+	CPUThreads     types.Int64  `tfsdk:"cpu_threads"`
+	MemoryBytes    types.Int64  `tfsdk:"memory_bytes"`
+	KernelVersion  types.String `tfsdk:"kernel_version"`
+	OS             types.String `tfsdk:"os"`
+	OSType         types.String `tfsdk:"os_type"`
+	QueriedVia     types.String `tfsdk:"queried_via"`
+}
+
+func NewNodeSystemInfoDataSource() datasource.DataSource {
+	return &NodeSystemInfoDataSource{dataSourceBase: newDataSourceBase("kineticpanel_node_system_info", scopeApplication)}
+}
+
+func (d *NodeSystemInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_system_info"
+}
+
+func (d *NodeSystemInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports a node's Wings version, Docker version and host details (Application API), so upgrade orchestration can find outdated daemons. " +
+			"The panel is asked first where it exposes the daemon's system information; otherwise the daemon is queried directly at the node's `scheme://fqdn:daemon_listen` " +
+			"with the node's daemon token, which needs Terraform to reach the daemon. Fields a daemon does not report are empty.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Node ID.",
+			},
+			"source": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "panel", "daemon"),
+				},
+				Description: "Where to read the information: `panel`, `daemon`, or `auto` to try the panel and fall back to the daemon. Default: `auto`.",
+			},
+			"minimum_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Wings version the node should run at least, e.g. `1.11.0`. Sets `outdated`.",
+			},
+			"wings_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Wings version, e.g. `1.11.13` (`develop` for development builds).",
+			},
+			"outdated": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether `wings_version` is older than `minimum_version`. Null without `minimum_version` or when `wings_version` is not a release version.",
+			},
+			"docker_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Docker engine version.",
+			},
+			"architecture": schema.StringAttribute{
+				Computed:    true,
+				Description: "CPU architecture, e.g. `amd64`.",
+			},
+			"cpu_threads": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of CPU threads.",
+			},
+			"memory_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Total memory of the host in bytes.",
+			},
+			"kernel_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Kernel version.",
+			},
+			"os": schema.StringAttribute{
+				Computed:    true,
+				Description: "Operating system name, e.g. `Ubuntu 22.04.4 LTS`.",
+			},
+			"os_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Operating system type, e.g. `linux`.",
+			},
+			"queried_via": schema.StringAttribute{
+				Computed:    true,
+				Description: "`panel` or `daemon`, whichever answered.",
+			},
+		},
+	}
+}
+
+func (d *NodeSystemInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config nodeSystemInfoModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeID := config.NodeID.ValueInt64()
+	source := "auto"
+	if !config.Source.IsNull() {
+		source = config.Source.ValueString()
+	}
+
+	var info wingsSystemInfo
+	var err error
+	via := "panel"
+	if source != "daemon" {
+		info, err = getPanelSystemInfo(d.client, nodeID)
+		unsupported := isUnsupported(err)
+		if unsupported && source == "panel" {
+			resp.Diagnostics.AddError("Unsupported Panel",
+				fmt.Sprintf("The panel does not expose the system information of node %d. Use source = \"daemon\" or \"auto\" to query the daemon directly.", nodeID))
+			return
+		}
+		if unsupported {
+			err = nil
+			via = "daemon"
+		}
+	} else {
+		via = "daemon"
+	}
+	if err == nil && via == "daemon" {
+		var node nodeAttributes
+		node, err = getNode(d.client, nodeID)
+		if err == nil {
+			info, err = getWingsSystemInfo(ctx, d.client, node)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch system information of node %d: %v", nodeID, err))
+		return
+	}
+
+	config.WingsVersion = types.StringValue(info.Version)
+	config.Outdated = types.BoolNull()
+	if !config.MinimumVersion.IsNull() {
+		if cmp, ok := compareVersions(info.Version, config.MinimumVersion.ValueString()); ok {
+			config.Outdated = types.BoolValue(cmp < 0)
+		}
+	}
+	config.DockerVersion = types.StringValue(info.Docker.Version)
+	config.Architecture = types.StringValue(info.System.Architecture)
+	config.CPUThreads = types.Int64Value(info.System.CPUThreads)
+	config.MemoryBytes = types.Int64Value(info.System.MemoryBytes)
+	config.KernelVersion = types.StringValue(info.System.KernelVersion)
+	config.OS = types.StringValue(info.System.OS)
+	config.OSType = types.StringValue(info.System.OSType)
+	config.QueriedVia = types.StringValue(via)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PanelDataSource{}
+
+// PanelDataSource reports what the provider detected about the panel and its API key.
+type PanelDataSource struct {
+	dataSourceBase
+}
+
+// panelModel holds the data source state.
+type panelModel struct {
+	Host     types.String `tfsdk:"host"`
+	BaseURL  types.String `tfsdk:"base_url"`
+	APIScope types.String `tfsdk:"api_scope"`
+	Flavor   types.String `tfsdk:"flavor"`
+	Version  types.String `tfsdk:"version"`
+	Readable types.Set    `tfsdk:"readable_scopes"`
+}
+
+func NewPanelDataSource() datasource.DataSource {
+	return &PanelDataSource{dataSourceBase: newDataSourceBase("kineticpanel_panel", "")}
+}
+
+func (d *PanelDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_panel"
+}
+
+func (d *PanelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports the panel the provider is configured for and what the API key can read, so configurations can check compatibility " +
+			"(e.g. with a `precondition`) before using resources that need newer endpoints. " +
+			"The panel does not publish its version or the key's permissions; both are inferred from which endpoints answer.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "Panel URL.",
+			},
+			"base_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "API base URL requests are sent to.",
+			},
+			"api_scope": schema.StringAttribute{
+				Computed:    true,
+				Description: "`application` or `client`, following the provider's `use_application` setting.",
+			},
+			"flavor": schema.StringAttribute{
+				Computed:    true,
+				Description: "`pterodactyl` or `pelican`. Only detectable with the Application API; `unknown` otherwise.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Best-effort minimum panel version (e.g. `>= 1.8`), or empty when it cannot be inferred.",
+			},
+			"readable_scopes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Resources the key can read: `servers`, `nodes`, `locations`, `nests`, `users` for Application keys; `account`, `servers` for Client keys.",
+			},
+		},
+	}
+}
+
+func (d *PanelDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	info, err := detectPanel(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query panel %s: %v", d.client.BaseURL, err))
+		return
+	}
+
+	readable, diags := stringSetValue(info.Readable)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := panelModel{
+		Host:     types.StringValue(info.Host),
+		BaseURL:  types.StringValue(d.client.BaseURL),
+		APIScope: types.StringValue(info.Scope),
+		Flavor:   types.StringValue(info.Flavor),
+		Version:  types.StringValue(info.Version),
+		Readable: readable,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -3,11 +3,16 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -15,116 +20,119 @@ import (
 var _ datasource.DataSource = &ServerDataSource{}
 
 type ServerDataSource struct {
-	client *Client
+	dataSourceBase
 }
 
+// serverDataModel holds the data source state: the common server attributes
+// plus the lookup options and the single-server extras.
 type serverDataModel struct {
+	clientServerModel
 	ServerID        types.String `tfsdk:"server_id"`
+	FailIfMissing   types.Bool   `tfsdk:"fail_if_missing"`
+	Retry           types.Object `tfsdk:"retry"`
+	Include         types.List   `tfsdk:"include"`
+	Exists          types.Bool   `tfsdk:"exists"`
 	ID              types.String `tfsdk:"id"`
-	Identifier      types.String `tfsdk:"identifier"`
-	InternalID      types.Int64  `tfsdk:"internal_id"`
-	Name            types.String `tfsdk:"name"`
-	Description     types.String `tfsdk:"description"`
-	Suspended       types.Bool   `tfsdk:"is_suspended"`
-	Installing      types.Bool   `tfsdk:"is_installing"`
-	Transferring    types.Bool   `tfsdk:"is_transferring"`
-	Node            types.String `tfsdk:"node"`
-	SFTPIP          types.String `tfsdk:"sftp_ip"`
-	SFTPPort        types.Int64  `tfsdk:"sftp_port"`
-	Invocation      types.String `tfsdk:"invocation"`
-	DockerImage     types.String `tfsdk:"docker_image"`
-	Memory          types.Int64  `tfsdk:"memory"`
-	Disk            types.Int64  `tfsdk:"disk"`
-	CPU             types.Int64  `tfsdk:"cpu"`
-	Swap            types.Int64  `tfsdk:"swap"`
-	IO              types.Int64  `tfsdk:"io"`
-	AllocationIP    types.String `tfsdk:"allocation_ip"`
-	AllocationPort  types.Int64  `tfsdk:"allocation_port"`
-	Environment     types.Map    `tfsdk:"environment"`
-	EggFeatures     types.List   `tfsdk:"egg_features"`
-	FeatureLimits   types.Object `tfsdk:"feature_limits"`
-	UserPermissions types.List   `tfsdk:"user_permissions"`
+	UserPermissions []string     `tfsdk:"user_permissions"`
 }
 
-func NewServerDataSource() datasource.DataSource { return &ServerDataSource{} }
+func NewServerDataSource() datasource.DataSource {
+	return &ServerDataSource{dataSourceBase: newDataSourceBase("kineticpanel_server", scopeClient)}
+}
 
 func (d *ServerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_server"
 }
 
 func (d *ServerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Fetches a single Kinetic Panel server (Client API).",
-		Attributes: map[string]schema.Attribute{
-			"server_id": schema.StringAttribute{
-				Required:    true,
-				Description: "Short server identifier (e.g. `19281aed`).",
-			},
-			"id":              schema.StringAttribute{Computed: true},
-			"identifier":      schema.StringAttribute{Computed: true},
-			"internal_id":     schema.Int64Attribute{Computed: true},
-			"name":            schema.StringAttribute{Computed: true},
-			"description":     schema.StringAttribute{Computed: true},
-			"is_suspended":    schema.BoolAttribute{Computed: true},
-			"is_installing":   schema.BoolAttribute{Computed: true},
-			"is_transferring": schema.BoolAttribute{Computed: true},
-			"node":            schema.StringAttribute{Computed: true},
-			"sftp_ip":         schema.StringAttribute{Computed: true},
-			"sftp_port":       schema.Int64Attribute{Computed: true},
-			"invocation":      schema.StringAttribute{Computed: true},
-			"docker_image":    schema.StringAttribute{Computed: true},
-			"memory":          schema.Int64Attribute{Computed: true},
-			"disk":            schema.Int64Attribute{Computed: true},
-			"cpu":             schema.Int64Attribute{Computed: true},
-			"swap":            schema.Int64Attribute{Computed: true},
-			"io":              schema.Int64Attribute{Computed: true},
-			"allocation_ip":   schema.StringAttribute{Computed: true},
-			"allocation_port": schema.Int64Attribute{Computed: true},
-			"environment": schema.MapAttribute{
-				ElementType: types.StringType,
-				Computed:    true,
-			},
-			"egg_features": schema.ListAttribute{
-				ElementType: types.StringType,
-				Computed:    true,
-			},
-			"feature_limits": schema.SingleNestedAttribute{
-				Computed: true,
-				Attributes: map[string]schema.Attribute{
-					"databases":   schema.Int64Attribute{Computed: true},
-					"allocations": schema.Int64Attribute{Computed: true},
-					"backups":     schema.Int64Attribute{Computed: true},
-				},
-			},
-			"user_permissions": schema.ListAttribute{
-				ElementType: types.StringType,
-				Computed:    true,
-			},
+	attrs := serverComputedAttributes()
+	attrs["server_id"] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
 		},
+		Description: "Short server identifier (e.g. `19281aed`). Exactly one of `server_id` and `name` must be set.",
+	}
+	attrs["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Server name, matched exactly or, failing that, as a case-insensitive prefix that only one server has. Resolved through the server list.",
+	}
+	attrs["fail_if_missing"] = schema.BoolAttribute{
+		Optional:    true,
+		Description: "Fail when the server does not exist. Set to `false` to probe for existence via `exists` instead. Default: true.",
+	}
+	attrs["retry"] = retryAttribute()
+	attrs["include"] = includeAttribute()
+	attrs["exists"] = schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether the server was found. When `false`, all other computed attributes are null.",
+	}
+	attrs["id"] = schema.StringAttribute{Computed: true}
+	attrs["user_permissions"] = schema.ListAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single Kinetic Panel server (Client API) by short identifier or by name.",
+		Attributes:  attrs,
 	}
 }
 
-func (d *ServerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
+func (d *ServerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.dataSourceBase.Configure(ctx, req, resp)
+	if DebugEnabled {
+		tflog.Info(ctx, "ServerDataSource configured")
 	}
-	client, ok := req.ProviderData.(*Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
-		)
-		return
+}
+
+// errServerNameNotFound is returned by resolveServerName when no server matches.
+var errServerNameNotFound = errors.New("no server found")
+
+// resolveServerName returns the identifier of the server called name. An
+// exact match wins; otherwise name must be a case-insensitive prefix of
+// exactly one server's name.
+func resolveServerName(client *Client, name string) (string, error) {
+	// filter[name] is a partial match, so it narrows the list to candidates.
+	servers, err := listClientServers(client, name, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to list servers: %w", err)
+	}
+	var exact, prefix []clientServerAttributes
+	for _, s := range servers {
+		switch {
+		case s.Name == name:
+			exact = append(exact, s)
+		case strings.HasPrefix(strings.ToLower(s.Name), strings.ToLower(name)):
+			prefix = append(prefix, s)
+		}
 	}
-	d.client = client
-	if DebugEnabled {
-		tflog.Info(context.Background(), "ServerDataSource configured")
+	matches := exact
+	if len(matches) == 0 {
+		matches = prefix
 	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("%w named %q or starting with it", errServerNameNotFound, name)
+	case 1:
+		return matches[0].Identifier, nil
+	}
+	ids := make([]string, 0, len(matches))
+	for _, s := range matches {
+		ids = append(ids, fmt.Sprintf("%s (%s)", s.Identifier, s.Name))
+	}
+	return "", fmt.Errorf("%d servers match %q: %s; use a longer name or server_id", len(matches), name, strings.Join(ids, ", "))
 }
 
 func (d *ServerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var cfg struct {
-		ServerID types.String `tfsdk:"server_id"`
+		ServerID      types.String `tfsdk:"server_id"`
+		Name          types.String `tfsdk:"name"`
+		FailIfMissing types.Bool   `tfsdk:"fail_if_missing"`
+		Retry         types.Object `tfsdk:"retry"`
+		Include       types.List   `tfsdk:"include"`
 	}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
 	if resp.Diagnostics.HasError() {
@@ -134,9 +142,39 @@ func (d *ServerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		tflog.Info(ctx, "Reading server", map[string]any{"server_id": cfg.ServerID.ValueString()})
 	}
 
-	path := "/servers/" + cfg.ServerID.ValueString()
-	body, err := d.client.Get(path)
+	if cfg.ServerID.IsNull() {
+		identifier, err := resolveServerName(d.client, cfg.Name.ValueString())
+		if err != nil {
+			if errors.Is(err, errServerNameNotFound) && !cfg.FailIfMissing.IsNull() && !cfg.FailIfMissing.ValueBool() {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exists"), false)...)
+				return
+			}
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Server Lookup Failed", err.Error())
+			return
+		}
+		cfg.ServerID = types.StringValue(identifier)
+	}
+
+	pth := "/servers/" + cfg.ServerID.ValueString()
+	include, diags := includeQuery(ctx, cfg.Include)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if include != "" {
+		pth += "?include=" + url.QueryEscape(include)
+	}
+	var body []byte
+	err := withRetry(ctx, cfg.Retry, func() (err error) {
+		body, err = d.client.Get(pth)
+		return err
+	})
 	if err != nil {
+		if isNotFound(err) && !cfg.FailIfMissing.IsNull() && !cfg.FailIfMissing.ValueBool() {
+			// State starts as a copy of the config, so only `exists` needs setting.
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exists"), false)...)
+			return
+		}
 		resp.Diagnostics.AddError("API request failed", err.Error())
 		return
 	}
@@ -145,55 +183,8 @@ func (d *ServerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// JSON structure (matches KineticPanel / Pterodactyl client API)
 	// -----------------------------------------------------------------
 	var apiResp struct {
-		Attributes struct {
-			Identifier     string `json:"identifier"`
-			InternalID     int64  `json:"internal_id"`
-			Name           string `json:"name"`
-			Description    string `json:"description"`
-			IsSuspended    bool   `json:"is_suspended"`
-			IsInstalling   bool   `json:"is_installing"`
-			IsTransferring bool   `json:"is_transferring"`
-			Node           string `json:"node"`
-			SFTPDetails    struct {
-				IP   string `json:"ip"`
-				Port int64  `json:"port"`
-			} `json:"sftp_details"`
-			Invocation    string   `json:"invocation"`
-			DockerImage   string   `json:"docker_image"`
-			EggFeatures   []string `json:"egg_features"`
-			FeatureLimits struct {
-				Databases   int64 `json:"databases"`
-				Allocations int64 `json:"allocations"`
-				Backups     int64 `json:"backups"`
-			} `json:"feature_limits"`
-			Limits struct {
-				Memory int64 `json:"memory"`
-				Swap   int64 `json:"swap"`
-				Disk   int64 `json:"disk"`
-				IO     int64 `json:"io"`
-				CPU    int64 `json:"cpu"`
-			} `json:"limits"`
-			Relationships struct {
-				Allocations struct {
-					Data []struct {
-						Attributes struct {
-							IP        string `json:"ip"`
-							Port      int64  `json:"port"`
-							IsDefault bool   `json:"is_default"`
-						} `json:"attributes"`
-					} `json:"data"`
-				} `json:"allocations"`
-				Variables struct {
-					Data []struct {
-						Attributes struct {
-							EnvVariable string `json:"env_variable"`
-							ServerValue string `json:"server_value"`
-						} `json:"attributes"`
-					} `json:"data"`
-				} `json:"variables"`
-			} `json:"relationships"`
-		} `json:"attributes"`
-		Meta struct {
+		Attributes clientServerAttributes `json:"attributes"`
+		Meta       struct {
 			UserPermissions []string `json:"user_permissions"`
 		} `json:"meta"`
 	}
@@ -207,85 +198,16 @@ func (d *ServerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			"name":       apiResp.Attributes.Name,
 		})
 	}
-	a := apiResp.Attributes
-
-	// ----- environment map -------------------------------------------------
-	envMap := make(map[string]attr.Value)
-	for _, v := range a.Relationships.Variables.Data {
-		envMap[v.Attributes.EnvVariable] = types.StringValue(v.Attributes.ServerValue)
-	}
-	environment, diags := types.MapValueFrom(ctx, types.StringType, envMap)
-	resp.Diagnostics.Append(diags...)
-
-	// ----- egg features (may be null) ------------------------------------
-	eggList := a.EggFeatures
-	if eggList == nil {
-		eggList = []string{}
-	}
-	eggFeatures, diags := types.ListValueFrom(ctx, types.StringType, eggList)
-	resp.Diagnostics.Append(diags...)
-
-	// ----- user permissions (from meta) ---------------------------------
-	userPerms := apiResp.Meta.UserPermissions
-	if userPerms == nil {
-		userPerms = []string{}
-	}
-	userPermsList, diags := types.ListValueFrom(ctx, types.StringType, userPerms)
-	resp.Diagnostics.Append(diags...)
-
-	// ----- feature limits -------------------------------------------------
-	featureLimitsAttrs := map[string]attr.Value{
-		"databases":   types.Int64Value(a.FeatureLimits.Databases),
-		"allocations": types.Int64Value(a.FeatureLimits.Allocations),
-		"backups":     types.Int64Value(a.FeatureLimits.Backups),
-	}
-	featureLimits, diags := types.ObjectValue(
-		map[string]attr.Type{
-			"databases":   types.Int64Type,
-			"allocations": types.Int64Type,
-			"backups":     types.Int64Type,
-		},
-		featureLimitsAttrs,
-	)
-	resp.Diagnostics.Append(diags...)
-
-	// ----- default allocation --------------------------------------------
-	var allocIP string
-	var allocPort int64
-	for _, alloc := range a.Relationships.Allocations.Data {
-		if alloc.Attributes.IsDefault {
-			allocIP = alloc.Attributes.IP
-			allocPort = alloc.Attributes.Port
-			break
-		}
-	}
 
 	state := serverDataModel{
-		ServerID:        cfg.ServerID,
-		ID:              types.StringValue(a.Identifier),
-		Identifier:      types.StringValue(a.Identifier),
-		InternalID:      types.Int64Value(a.InternalID),
-		Name:            types.StringValue(a.Name),
-		Description:     types.StringValue(a.Description),
-		Suspended:       types.BoolValue(a.IsSuspended),
-		Installing:      types.BoolValue(a.IsInstalling),
-		Transferring:    types.BoolValue(a.IsTransferring),
-		Node:            types.StringValue(a.Node),
-		SFTPIP:          types.StringValue(a.SFTPDetails.IP),
-		SFTPPort:        types.Int64Value(a.SFTPDetails.Port),
-		Invocation:      types.StringValue(a.Invocation),
-		DockerImage:     types.StringValue(a.DockerImage),
-		Memory:          types.Int64Value(a.Limits.Memory),
-		Disk:            types.Int64Value(a.Limits.Disk),
-		CPU:             types.Int64Value(a.Limits.CPU),
-		Swap:            types.Int64Value(a.Limits.Swap),
-		IO:              types.Int64Value(a.Limits.IO),
-		AllocationIP:    types.StringValue(allocIP),
-		AllocationPort:  types.Int64Value(allocPort),
-		Environment:     environment,
-		EggFeatures:     eggFeatures,
-		FeatureLimits:   featureLimits,
-		UserPermissions: userPermsList,
+		clientServerModel: flattenClientServer(apiResp.Attributes),
+		ServerID:          cfg.ServerID,
+		FailIfMissing:     cfg.FailIfMissing,
+		Retry:             cfg.Retry,
+		Include:           cfg.Include,
+		Exists:            types.BoolValue(true),
+		ID:                types.StringValue(apiResp.Attributes.Identifier),
+		UserPermissions:   nonNil(apiResp.Meta.UserPermissions), // from meta, single server only
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -5,31 +5,50 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
-	"regexp"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSource = &ServerActivityLogsDataSource{}
 
-// ServerActivityLogsDataSource fetches recent console logs for a server.
+// ServerActivityLogsDataSource fetches the panel activity log of a server.
 type ServerActivityLogsDataSource struct {
-	client *Client
+	dataSourceBase
 }
 
-// logsModel holds the data source state.
-type logsModel struct {
-	ServerID   types.String `tfsdk:"server_id"`
-	Lines      types.Int64  `tfsdk:"lines"`      // how many lines to fetch (default 50)
-	Logs       types.List   `tfsdk:"logs"`       // list of log lines
-	Timestamps types.List   `tfsdk:"timestamps"` // list of timestamps (ISO 8601)
+// activityLogsModel holds the data source state.
+type activityLogsModel struct {
+	ServerID types.String `tfsdk:"server_id"`
+	Event    types.String `tfsdk:"event"`     // partial match on the event name
+	Since    types.String `tfsdk:"since"`     // RFC 3339, inclusive
+	Until    types.String `tfsdk:"until"`     // RFC 3339, exclusive
+	PerPage  types.Int64  `tfsdk:"per_page"`  // page size (default 50)
+	MaxPages types.Int64  `tfsdk:"max_pages"` // pages to walk (default 1)
+	Entries  types.List   `tfsdk:"entries"`
+}
+
+var activityEntryAttrTypes = map[string]attr.Type{
+	"id":             types.StringType,
+	"batch":          types.StringType,
+	"event":          types.StringType,
+	"description":    types.StringType,
+	"ip":             types.StringType,
+	"is_api":         types.BoolType,
+	"timestamp":      types.StringType,
+	"properties":     types.MapType{ElemType: types.StringType},
+	"actor_uuid":     types.StringType,
+	"actor_username": types.StringType,
+	"actor_email":    types.StringType,
 }
 
 func NewServerActivityLogsDataSource() datasource.DataSource {
-	return &ServerActivityLogsDataSource{}
+	return &ServerActivityLogsDataSource{dataSourceBase: newDataSourceBase("kineticpanel_server_activity_logs", scopeClient)}
 }
 
 func (d *ServerActivityLogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,133 +57,211 @@ func (d *ServerActivityLogsDataSource) Metadata(_ context.Context, req datasourc
 
 func (d *ServerActivityLogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches recent console activity logs for a Kinetic Panel server (Client API).",
+		Description: "Fetches the activity log (power actions, file edits, logins, ...) of a Kinetic Panel server (Client API). Entries are returned most recent first.",
 		Attributes: map[string]schema.Attribute{
 			"server_id": schema.StringAttribute{
 				Required:    true,
 				Description: "Short server identifier (e.g. `abc123`).",
 			},
-			"lines": schema.Int64Attribute{
+			"event": schema.StringAttribute{
 				Optional:    true,
-				Description: "Number of recent log lines to fetch. Default: 50. Max: 100.",
+				Description: "Only return events whose name contains this value (e.g. `server:power` or `server:file.write`).",
 			},
-			"logs": schema.ListAttribute{
-				ElementType: types.StringType,
-				Computed:    true,
-				Description: "List of console log lines (most recent first).",
+			"since": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return events at or after this RFC 3339 timestamp.",
+			},
+			"until": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return events before this RFC 3339 timestamp.",
 			},
-			"timestamps": schema.ListAttribute{
-				ElementType: types.StringType,
+			"per_page": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of events requested per page. Default: 50. Max: 100.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"max_pages": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of pages to fetch. Default: 1.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
 				Computed:    true,
-				Description: "List of timestamps (ISO 8601) corresponding to each log line.",
+				Description: "Matching activity log entries, most recent first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":          schema.StringAttribute{Computed: true},
+						"batch":       schema.StringAttribute{Computed: true},
+						"event":       schema.StringAttribute{Computed: true},
+						"description": schema.StringAttribute{Computed: true},
+						"ip":          schema.StringAttribute{Computed: true},
+						"is_api":      schema.BoolAttribute{Computed: true},
+						"timestamp":   schema.StringAttribute{Computed: true},
+						"properties": schema.MapAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Event properties; non-string values are JSON encoded.",
+						},
+						"actor_uuid":     schema.StringAttribute{Computed: true},
+						"actor_username": schema.StringAttribute{Computed: true},
+						"actor_email":    schema.StringAttribute{Computed: true},
+					},
+				},
 			},
 		},
 	}
 }
 
-func (d *ServerActivityLogsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(*Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
-		)
-		return
-	}
-	d.client = client
-}
-
 func (d *ServerActivityLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config struct {
-		ServerID types.String `tfsdk:"server_id"`
-		Lines    types.Int64  `tfsdk:"lines"`
-	}
+	var config activityLogsModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var since, until time.Time
+	if !config.Since.IsNull() {
+		t, err := time.Parse(time.RFC3339, config.Since.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid since", err.Error())
+			return
+		}
+		since = t
+	}
+	if !config.Until.IsNull() {
+		t, err := time.Parse(time.RFC3339, config.Until.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid until", err.Error())
+			return
+		}
+		until = t
+	}
+
 	serverID := config.ServerID.ValueString()
-	lines := int(config.Lines.ValueInt64())
-	if lines == 0 {
-		lines = 50
+	perPage := config.PerPage.ValueInt64()
+	if perPage == 0 {
+		perPage = 50
 	}
-	if lines > 100 {
-		lines = 100
+	maxPages := config.MaxPages.ValueInt64()
+	if maxPages == 0 {
+		maxPages = 1
 	}
 
-	// Build URL with query param: ?logs=50
-	u, _ := url.Parse("/servers/" + serverID + "/websocket")
-	q := u.Query()
-	q.Set("logs", fmt.Sprintf("%d", lines))
-	u.RawQuery = q.Encode()
-	path := u.String()
+	entries := []attr.Value{}
 
-	body, err := d.client.Get(path)
-	if err != nil {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch logs for server %s: %v", serverID, err))
-		return
-	}
+pages:
+	for page, totalPages := int64(1), int64(1); page <= totalPages && page <= maxPages; page++ {
+		q := url.Values{}
+		q.Set("page", fmt.Sprintf("%d", page))
+		q.Set("per_page", fmt.Sprintf("%d", perPage))
+		q.Set("sort", "-timestamp")
+		q.Set("include", "actor")
+		if !config.Event.IsNull() {
+			q.Set("filter[event]", config.Event.ValueString())
+		}
 
-	var apiResp struct {
-		Data []struct {
-			Event     string   `json:"event"`
-			Args      []string `json:"args"`
-			Timestamp string   `json:"timestamp"`
-		} `json:"data"`
-	}
+		body, err := d.client.Get("/servers/" + serverID + "/activity?" + q.Encode())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch activity for server %s: %v", serverID, err))
+			return
+		}
 
-	if err := json.Unmarshal(body, &apiResp); err != nil {
-		resp.Diagnostics.AddError("JSON Parse Error", err.Error())
-		return
-	}
+		var apiResp struct {
+			Data []struct {
+				Attributes struct {
+					ID            string          `json:"id"`
+					Batch         *string         `json:"batch"`
+					Event         string          `json:"event"`
+					IsAPI         bool            `json:"is_api"`
+					IP            *string         `json:"ip"`
+					Description   *string         `json:"description"`
+					RawProperties json.RawMessage `json:"properties"`
+					Timestamp     string          `json:"timestamp"`
+					Relationships struct {
+						Actor struct {
+							Attributes struct {
+								UUID     string `json:"uuid"`
+								Username string `json:"username"`
+								Email    string `json:"email"`
+							} `json:"attributes"`
+						} `json:"actor"`
+					} `json:"relationships"`
+				} `json:"attributes"`
+			} `json:"data"`
+			Meta struct {
+				Pagination struct {
+					TotalPages int64 `json:"total_pages"`
+				} `json:"pagination"`
+			} `json:"meta"`
+		}
+		if err := json.Unmarshal(body, &apiResp); err != nil {
+			resp.Diagnostics.AddError("JSON Parse Error", err.Error())
+			return
+		}
+		totalPages = apiResp.Meta.Pagination.TotalPages
 
-	var logLines []string
-	var timestamps []string
-
-	for _, entry := range apiResp.Data {
-		if entry.Event == "console output" && len(entry.Args) > 0 {
-			// Clean up ANSI codes and trim
-			line := strings.TrimSpace(entry.Args[0])
-			line = stripANSI(line)
-			if line != "" {
-				logLines = append(logLines, line)
-				timestamps = append(timestamps, entry.Timestamp)
+		for _, item := range apiResp.Data {
+			a := item.Attributes
+
+			ts, err := time.Parse(time.RFC3339, a.Timestamp)
+			if err == nil {
+				if !until.IsZero() && !ts.Before(until) {
+					continue
+				}
+				if !since.IsZero() && ts.Before(since) {
+					// Sorted newest first, so nothing further can match.
+					break pages
+				}
 			}
+
+			// An event without properties is sent as `[]` rather than `{}`.
+			props := map[string]string{}
+			var raw map[string]json.RawMessage
+			if json.Unmarshal(a.RawProperties, &raw) == nil {
+				for k, v := range raw {
+					var str string
+					if json.Unmarshal(v, &str) == nil {
+						props[k] = str
+					} else {
+						props[k] = string(v)
+					}
+				}
+			}
+			properties, diags := stringMapValue(props)
+			resp.Diagnostics.Append(diags...)
+
+			actor := a.Relationships.Actor.Attributes
+			entry, diags := types.ObjectValue(activityEntryAttrTypes, map[string]attr.Value{
+				"id":             types.StringValue(a.ID),
+				"batch":          types.StringPointerValue(a.Batch),
+				"event":          types.StringValue(a.Event),
+				"description":    types.StringPointerValue(a.Description),
+				"ip":             types.StringPointerValue(a.IP),
+				"is_api":         types.BoolValue(a.IsAPI),
+				"timestamp":      types.StringValue(a.Timestamp),
+				"properties":     properties,
+				"actor_uuid":     types.StringValue(actor.UUID),
+				"actor_username": types.StringValue(actor.Username),
+				"actor_email":    types.StringValue(actor.Email),
+			})
+			resp.Diagnostics.Append(diags...)
+			entries = append(entries, entry)
 		}
 	}
-
-	// Reverse to most recent first
-	for i := len(logLines)/2 - 1; i >= 0; i-- {
-		opp := len(logLines) - 1 - i
-		logLines[i], logLines[opp] = logLines[opp], logLines[i]
-		timestamps[i], timestamps[opp] = timestamps[opp], timestamps[i]
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	logsList, diags := types.ListValueFrom(ctx, types.StringType, logLines)
-	resp.Diagnostics.Append(diags...)
-	tsList, diags := types.ListValueFrom(ctx, types.StringType, timestamps)
+	entryList, diags := types.ListValue(types.ObjectType{AttrTypes: activityEntryAttrTypes}, entries)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state := logsModel{
-		ServerID:   config.ServerID,
-		Lines:      types.Int64Value(int64(lines)),
-		Logs:       logsList,
-		Timestamps: tsList,
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-}
-
-// stripANSI removes ANSI color codes (basic)
-func stripANSI(str string) string {
-	const ansi = "\x1b\\[[0-9;]*[a-zA-Z]"
-	re := regexp.MustCompile(ansi)
-	return re.ReplaceAllString(str, "")
+	config.Entries = entryList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
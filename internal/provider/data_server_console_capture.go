@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServerConsoleCaptureDataSource{}
+
+// ServerConsoleCaptureDataSource records console output from the server websocket.
+type ServerConsoleCaptureDataSource struct {
+	dataSourceBase
+}
+
+// consoleCaptureModel holds the data source state.
+type consoleCaptureModel struct {
+	ServerID        types.String `tfsdk:"server_id"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+	MaxLines        types.Int64  `tfsdk:"max_lines"`
+	History         types.Bool   `tfsdk:"history"`
+	Lines           types.List   `tfsdk:"lines"`
+	Output          types.String `tfsdk:"output"`
+	State           types.String `tfsdk:"state"`
+}
+
+func NewServerConsoleCaptureDataSource() datasource.DataSource {
+	return &ServerConsoleCaptureDataSource{dataSourceBase: newDataSourceBase("kineticpanel_server_console_capture", scopeClient)}
+}
+
+func (d *ServerConsoleCaptureDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_console_capture"
+}
+
+func (d *ServerConsoleCaptureDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Connects to a server's console websocket (Client API) and captures its output for `duration_seconds` or until `max_lines` lines arrived, whichever comes first. " +
+			"With `history` the capture starts with the node's console buffer, i.e. the output since the server last started, so checks such as \"the last boot logged `Done`\" can run at plan time. " +
+			"The key needs the `websocket.connect` permission.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 300),
+				},
+				Description: "How long to listen. Default: 5.",
+			},
+			"max_lines": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Stop after this many lines. Default: no limit.",
+			},
+			"history": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Start with the console output since the server last started. Default: true.",
+			},
+			"lines": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Captured console lines, oldest first, without ANSI color codes.",
+			},
+			"output": schema.StringAttribute{
+				Computed:    true,
+				Description: "`lines` joined with newlines, for `strcontains` and `regex` checks.",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "Power state announced by the node during the capture (e.g. `running`), or empty if none was.",
+			},
+		},
+	}
+}
+
+func (d *ServerConsoleCaptureDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config consoleCaptureModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := config.ServerID.ValueString()
+	duration := int64(5)
+	if !config.DurationSeconds.IsNull() {
+		duration = config.DurationSeconds.ValueInt64()
+	}
+	history := config.History.IsNull() || config.History.ValueBool()
+
+	capture, err := captureConsole(ctx, d.client, serverID, time.Duration(duration)*time.Second, int(config.MaxLines.ValueInt64()), history)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to capture console of server %s: %v", serverID, err))
+		return
+	}
+
+	lines, diags := stringListValue(capture.Lines)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Lines = lines
+	config.Output = types.StringValue(strings.Join(capture.Lines, "\n"))
+	config.State = types.StringValue(capture.State)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServerConsoleLogsDataSource{}
+
+// ServerConsoleLogsDataSource fetches recent console logs for a server.
+type ServerConsoleLogsDataSource struct {
+	dataSourceBase
+}
+
+// consoleLogsModel holds the data source state.
+type consoleLogsModel struct {
+	ServerID   types.String `tfsdk:"server_id"`
+	Lines      types.Int64  `tfsdk:"lines"`      // how many lines to fetch (default 50)
+	Logs       types.List   `tfsdk:"logs"`       // list of log lines
+	Timestamps types.List   `tfsdk:"timestamps"` // list of timestamps (ISO 8601)
+}
+
+func NewServerConsoleLogsDataSource() datasource.DataSource {
+	return &ServerConsoleLogsDataSource{dataSourceBase: newDataSourceBase("kineticpanel_server_console_logs", scopeClient)}
+}
+
+func (d *ServerConsoleLogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_console_logs"
+}
+
+func (d *ServerConsoleLogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches recent console output for a Kinetic Panel server (Client API) from its console websocket. To listen for new output or filter a boot log, use `kineticpanel_server_console_capture`. For panel audit events use `kineticpanel_server_activity_logs`.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"lines": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of recent log lines to fetch. Default: 50. Max: 100.",
+			},
+			"logs": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "List of console log lines (most recent first).",
+			},
+			"timestamps": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "List of timestamps (ISO 8601) at which each log line was received; the node does not timestamp console output.",
+			},
+		},
+	}
+}
+
+func (d *ServerConsoleLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config struct {
+		ServerID types.String `tfsdk:"server_id"`
+		Lines    types.Int64  `tfsdk:"lines"`
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := config.ServerID.ValueString()
+	lines := int(config.Lines.ValueInt64())
+	if lines == 0 {
+		lines = 50
+	}
+	if lines > 100 {
+		lines = 100
+	}
+
+	// The node keeps the console since the last start and sends it right
+	// after the websocket authenticated; a few seconds are enough to get it.
+	capture, err := captureConsole(ctx, d.client, serverID, 3*time.Second, 0, true)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch logs for server %s: %v", serverID, err))
+		return
+	}
+	logLines, timestamps := capture.Lines, capture.Times
+	if len(logLines) > lines {
+		logLines = logLines[len(logLines)-lines:]
+		timestamps = timestamps[len(timestamps)-lines:]
+	}
+
+	// Reverse to most recent first
+	for i := len(logLines)/2 - 1; i >= 0; i-- {
+		opp := len(logLines) - 1 - i
+		logLines[i], logLines[opp] = logLines[opp], logLines[i]
+		timestamps[i], timestamps[opp] = timestamps[opp], timestamps[i]
+	}
+
+	logsList, diags := stringListValue(logLines)
+	resp.Diagnostics.Append(diags...)
+	tsList, diags := stringListValue(timestamps)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := consoleLogsModel{
+		ServerID:   config.ServerID,
+		Lines:      types.Int64Value(int64(lines)),
+		Logs:       logsList,
+		Timestamps: tsList,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// stripANSI removes ANSI color codes (basic)
+func stripANSI(str string) string {
+	const ansi = "\x1b\\[[0-9;]*[a-zA-Z]"
+	re := regexp.MustCompile(ansi)
+	return re.ReplaceAllString(str, "")
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServerDatabasesDataSource{}
+
+// ServerDatabasesDataSource lists server databases with their database host.
+type ServerDatabasesDataSource struct {
+	dataSourceBase
+}
+
+// serverDatabasesModel holds the data source state.
+type serverDatabasesModel struct {
+	ServerID  types.Int64 `tfsdk:"server_id"`
+	Databases types.List  `tfsdk:"databases"`
+}
+
+var serverDatabaseAttrTypes = map[string]attr.Type{
+	"id":              types.Int64Type,
+	"server_id":       types.Int64Type,
+	"database":        types.StringType,
+	"username":        types.StringType,
+	"remote":          types.StringType,
+	"max_connections": types.Int64Type,
+	"host_id":         types.Int64Type,
+	"host_name":       types.StringType,
+	"host_address":    types.StringType,
+	"host_port":       types.Int64Type,
+	"created_at":      types.StringType,
+}
+
+func NewServerDatabasesDataSource() datasource.DataSource {
+	return &ServerDatabasesDataSource{dataSourceBase: newDataSourceBase("kineticpanel_server_databases", scopeApplication)}
+}
+
+func (d *ServerDatabasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_databases"
+}
+
+func (d *ServerDatabasesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the databases of one server, or of every server on the panel, with their database host (Application API). " +
+			"Without `server_id` one request is made per server, so prefer filtering on large panels.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Numeric server ID. When omitted, the databases of all servers are listed.",
+			},
+			"databases": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Databases ordered by server, then as returned by the panel.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":        schema.Int64Attribute{Computed: true},
+						"server_id": schema.Int64Attribute{Computed: true},
+						"database": schema.StringAttribute{
+							Computed:    true,
+							Description: "Database name on the host.",
+						},
+						"username": schema.StringAttribute{Computed: true},
+						"remote": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hosts allowed to connect (`%` for any).",
+						},
+						"max_connections": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Connection limit, 0 for unlimited.",
+						},
+						"host_id":      schema.Int64Attribute{Computed: true},
+						"host_name":    schema.StringAttribute{Computed: true},
+						"host_address": schema.StringAttribute{Computed: true},
+						"host_port":    schema.Int64Attribute{Computed: true},
+						"created_at":   schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ServerDatabasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serverDatabasesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverIDs := []int64{config.ServerID.ValueInt64()}
+	if config.ServerID.IsNull() {
+		ids, err := listAppServerIDs(d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list servers: %v", err))
+			return
+		}
+		serverIDs = ids
+	}
+
+	items := []attr.Value{}
+	for _, serverID := range serverIDs {
+		dbs, err := listAppServerDatabases(d.client, serverID)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list databases for server %d: %v", serverID, err))
+			return
+		}
+		for _, db := range dbs {
+			host := db.Relationships.Host.Attributes
+			obj, diags := types.ObjectValue(serverDatabaseAttrTypes, map[string]attr.Value{
+				"id":              types.Int64Value(db.ID),
+				"server_id":       types.Int64Value(serverID),
+				"database":        types.StringValue(db.Database),
+				"username":        types.StringValue(db.Username),
+				"remote":          types.StringValue(db.Remote),
+				"max_connections": types.Int64Value(db.MaxConnections),
+				"host_id":         types.Int64Value(db.Host),
+				"host_name":       types.StringValue(host.Name),
+				"host_address":    types.StringValue(host.Host),
+				"host_port":       types.Int64Value(host.Port),
+				"created_at":      types.StringValue(db.CreatedAt),
+			})
+			resp.Diagnostics.Append(diags...)
+			items = append(items, obj)
+		}
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: serverDatabaseAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Databases = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServerDirectoryUsageDataSource{}
+
+// ServerDirectoryUsageDataSource sums the size of each entry of a directory.
+type ServerDirectoryUsageDataSource struct {
+	dataSourceBase
+}
+
+// directoryUsageModel holds the data source state.
+type directoryUsageModel struct {
+	ServerID       types.String `tfsdk:"server_id"`
+	Path           types.String `tfsdk:"path"`
+	MaxDepth       types.Int64  `tfsdk:"max_depth"`       // default 10
+	MaxDirectories types.Int64  `tfsdk:"max_directories"` // default 500
+	Entries        types.List   `tfsdk:"entries"`
+	TotalBytes     types.Int64  `tfsdk:"total_bytes"`
+	DiskLimitBytes types.Int64  `tfsdk:"disk_limit_bytes"`
+	Truncated      types.Bool   `tfsdk:"truncated"`
+}
+
+var directoryUsageEntryAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"is_file":     types.BoolType,
+	"size_bytes":  types.Int64Type,
+	"modified_at": types.StringType,
+}
+
+func NewServerDirectoryUsageDataSource() datasource.DataSource {
+	return &ServerDirectoryUsageDataSource{dataSourceBase: newDataSourceBase("kineticpanel_server_directory_usage", scopeClient)}
+}
+
+func (d *ServerDirectoryUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_directory_usage"
+}
+
+func (d *ServerDirectoryUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists a directory inside a Kinetic Panel server with the total size of each entry (Client API), e.g. to fail a plan through a precondition when world folders approach the disk limit. " +
+			"Subdirectories are walked with one listing request each, up to `max_depth` levels and `max_directories` listings.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Directory relative to the server root. Default: `/`.",
+			},
+			"max_depth": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 50),
+				},
+				Description: "How many levels below `path` to walk; deeper contents are not counted. Default: 10.",
+			},
+			"max_directories": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Stop walking after listing this many directories. Default: 500.",
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Entries of the directory, largest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":    schema.StringAttribute{Computed: true},
+						"is_file": schema.BoolAttribute{Computed: true},
+						"size_bytes": schema.Int64Attribute{
+							Computed:    true,
+							Description: "File size, or the total size of a directory's contents.",
+						},
+						"modified_at": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+			"total_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Sum of `size_bytes` over all entries.",
+			},
+			"disk_limit_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Disk limit of the server, 0 for unlimited.",
+			},
+			"truncated": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether `max_depth` or `max_directories` stopped the walk, making the sizes lower bounds.",
+			},
+		},
+	}
+}
+
+// directoryWalk sums directory sizes within a depth and listing budget.
+type directoryWalk struct {
+	client    *Client
+	serverID  string
+	budget    int64
+	truncated bool
+}
+
+// size returns the total size of the files below dir.
+func (w *directoryWalk) size(dir string, depth int64) (int64, error) {
+	if depth < 0 || w.budget <= 0 {
+		w.truncated = true
+		return 0, nil
+	}
+	w.budget--
+	objects, err := listServerDirectory(w.client, w.serverID, dir)
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, o := range objects {
+		switch {
+		case o.IsFile || o.IsSymlink:
+			total += o.Size
+		default:
+			n, err := w.size(path.Join(dir, o.Name), depth-1)
+			if err != nil {
+				return 0, err
+			}
+			total += n
+		}
+	}
+	return total, nil
+}
+
+func (d *ServerDirectoryUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config directoryUsageModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := config.ServerID.ValueString()
+	dir := normalizeServerPath(config.Path.ValueString())
+	maxDepth := int64(10)
+	if !config.MaxDepth.IsNull() {
+		maxDepth = config.MaxDepth.ValueInt64()
+	}
+	walk := &directoryWalk{client: d.client, serverID: serverID, budget: 500}
+	if !config.MaxDirectories.IsNull() {
+		walk.budget = config.MaxDirectories.ValueInt64()
+	}
+
+	objects, err := listServerDirectory(d.client, serverID, dir)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list %s on server %s: %v", dir, serverID, err))
+		return
+	}
+	walk.budget--
+
+	type entry struct {
+		object serverFileObject
+		size   int64
+	}
+	entries := make([]entry, 0, len(objects))
+	var total int64
+	for _, o := range objects {
+		size := o.Size
+		if !o.IsFile && !o.IsSymlink {
+			size, err = walk.size(path.Join(dir, o.Name), maxDepth-1)
+			if err != nil {
+				resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list %s on server %s: %v", path.Join(dir, o.Name), serverID, err))
+				return
+			}
+		}
+		entries = append(entries, entry{object: o, size: size})
+		total += size
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].size > entries[j].size })
+
+	items := make([]attr.Value, 0, len(entries))
+	for _, e := range entries {
+		obj, diags := types.ObjectValue(directoryUsageEntryAttrTypes, map[string]attr.Value{
+			"name":        types.StringValue(e.object.Name),
+			"is_file":     types.BoolValue(e.object.IsFile),
+			"size_bytes":  types.Int64Value(e.size),
+			"modified_at": types.StringValue(e.object.ModifiedAt),
+		})
+		resp.Diagnostics.Append(diags...)
+		items = append(items, obj)
+	}
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: directoryUsageEntryAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit, err := clientServerDiskLimit(d.client, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch limits for server %s: %v", serverID, err))
+		return
+	}
+
+	config.Entries = list
+	config.TotalBytes = types.Int64Value(total)
+	config.DiskLimitBytes = types.Int64Value(limit)
+	config.Truncated = types.BoolValue(walk.truncated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// clientServerDiskLimit returns the disk limit of a server in bytes; the
+// panel reports it in MiB, with 0 meaning unlimited.
+func clientServerDiskLimit(client *Client, serverID string) (int64, error) {
+	body, err := client.Get("/servers/" + serverID)
+	if err != nil {
+		return 0, err
+	}
+	var apiResp struct {
+		Attributes struct {
+			Limits struct {
+				Disk int64 `json:"disk"`
+			} `json:"limits"`
+		} `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return 0, fmt.Errorf("JSON parse error: %w", err)
+	}
+	return apiResp.Attributes.Limits.Disk * 1024 * 1024, nil
+}
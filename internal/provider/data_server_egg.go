@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServerEggDataSource{}
+
+// ServerEggDataSource fetches what the Client API reveals about a server's egg.
+type ServerEggDataSource struct {
+	dataSourceBase
+}
+
+// serverEggDataModel holds the data source state.
+type serverEggDataModel struct {
+	ServerID types.String `tfsdk:"server_id"`
+	UUID     types.String `tfsdk:"uuid"`
+	Name     types.String `tfsdk:"name"`
+	Features types.List   `tfsdk:"features"`
+	GameType types.String `tfsdk:"game_type"`
+}
+
+func NewServerEggDataSource() datasource.DataSource {
+	return &ServerEggDataSource{dataSourceBase: newDataSourceBase("kineticpanel_server_egg", scopeClient)}
+}
+
+func (d *ServerEggDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_egg"
+}
+
+func (d *ServerEggDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the egg of a server as far as the Client API shows it, so modules used with client keys can branch on the game without an application key. " +
+			"Egg IDs, variables and install scripts need the Application API; see `kineticpanel_nests`.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"uuid": schema.StringAttribute{
+				Computed:    true,
+				Description: "UUID of the egg, stable across panels the egg is imported into.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the egg, e.g. `Paper`.",
+			},
+			"features": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Features the egg declares, e.g. `eula` and `java_version` for Minecraft.",
+			},
+			"game_type": schema.StringAttribute{
+				Computed: true,
+				Description: "Kind of game guessed from `features`: `minecraft`, `steam` or `other`. " +
+					"The same guess decides `connection_string` on `kineticpanel_server`.",
+			},
+		},
+	}
+}
+
+func (d *ServerEggDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serverEggDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := config.ServerID.ValueString()
+	body, err := d.client.Get("/servers/" + serverID + "?include=egg")
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read server %s: %v", serverID, err))
+		return
+	}
+	var apiResp struct {
+		Attributes clientServerAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to parse server %s: %v", serverID, err))
+		return
+	}
+	a := apiResp.Attributes
+	if a.Relationships.Egg == nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("The panel returned no egg for server %s; it may not support `include=egg` on the Client API.", serverID))
+		return
+	}
+
+	features, diags := stringListValue(a.EggFeatures)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.UUID = types.StringValue(a.Relationships.Egg.Attributes.UUID)
+	config.Name = types.StringValue(a.Relationships.Egg.Attributes.Name)
+	config.Features = features
+	config.GameType = types.StringValue(eggGameType(a.EggFeatures))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
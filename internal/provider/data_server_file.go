@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServerFileDataSource{}
+
+// ServerFileDataSource reads a file's metadata and, when small enough, its contents.
+type ServerFileDataSource struct {
+	dataSourceBase
+}
+
+// serverFileDataModel holds the data source state.
+type serverFileDataModel struct {
+	ServerID        types.String `tfsdk:"server_id"`
+	Path            types.String `tfsdk:"path"`
+	MaxContentBytes types.Int64  `tfsdk:"max_content_bytes"` // default 1 MiB
+	DownloadPath    types.String `tfsdk:"download_path"`
+	Size            types.Int64  `tfsdk:"size"`
+	Mimetype        types.String `tfsdk:"mimetype"`
+	ModifiedAt      types.String `tfsdk:"modified_at"`
+	Content         types.String `tfsdk:"content"`
+	SHA256          types.String `tfsdk:"sha256"` // of the downloaded file
+}
+
+// defaultMaxContentBytes is the largest file whose contents are read by default.
+const defaultMaxContentBytes = 1 << 20
+
+func NewServerFileDataSource() datasource.DataSource {
+	return &ServerFileDataSource{dataSourceBase: newDataSourceBase("kineticpanel_server_file", scopeClient)}
+}
+
+func (d *ServerFileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_file"
+}
+
+func (d *ServerFileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a file inside a Kinetic Panel server (Client API). Metadata comes from the directory listing, so checking the size of a large world archive " +
+			"does not transfer it. Contents are only read for files up to `max_content_bytes`; larger files can be streamed to disk with `download_path`.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "File path relative to the server root (e.g. `server.properties`).",
+			},
+			"max_content_bytes": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, maxResponseBytes),
+				},
+				Description: "Largest file whose contents are read into `content`. Default: 1048576 (1 MiB).",
+			},
+			"download_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Local path to stream the file to, whatever its size. The file is written to a temporary name and renamed once complete.",
+			},
+			"size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "File size in bytes.",
+			},
+			"mimetype": schema.StringAttribute{Computed: true},
+			"modified_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last modification time.",
+			},
+			"content": schema.StringAttribute{
+				Computed:    true,
+				Description: "File contents, or null when the file is larger than `max_content_bytes` or not valid UTF-8.",
+			},
+			"sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the downloaded file, or null without `download_path`.",
+			},
+		},
+	}
+}
+
+func (d *ServerFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serverFileDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, file := config.ServerID.ValueString(), config.Path.ValueString()
+	info, err := statServerFile(d.client, serverID, file)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to stat %s on server %s: %v", file, serverID, err))
+		return
+	}
+	if !info.IsFile {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Not a File", fmt.Sprintf("%s on server %s is a directory.", file, serverID))
+		return
+	}
+	config.Size = types.Int64Value(info.Size)
+	config.Mimetype = types.StringValue(info.Mimetype)
+	config.ModifiedAt = types.StringValue(info.ModifiedAt)
+
+	limit := int64(defaultMaxContentBytes)
+	if !config.MaxContentBytes.IsNull() {
+		limit = config.MaxContentBytes.ValueInt64()
+	}
+	config.Content = types.StringNull()
+	if info.Size <= limit {
+		// The cap also guards against the file growing since it was listed.
+		body, err := readServerFileLimited(d.client, serverID, file, limit)
+		switch {
+		case errors.Is(err, errResponseTooLarge):
+			// Grew past the cap; leave content null as for any large file.
+		case err != nil:
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read %s on server %s: %v", file, serverID, err))
+			return
+		case utf8.Valid(body):
+			config.Content = types.StringValue(string(body))
+		}
+	}
+
+	config.SHA256 = types.StringNull()
+	if dest := config.DownloadPath.ValueString(); dest != "" {
+		size, sum, err := downloadServerFile(ctx, d.client, serverID, file, dest)
+		if err != nil {
+			resp.Diagnostics.AddError("Download Failed", fmt.Sprintf("Failed to download %s from server %s to %s: %v", file, serverID, dest, err))
+			return
+		}
+		config.Size = types.Int64Value(size)
+		config.SHA256 = types.StringValue(sum)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -14,7 +14,7 @@ var _ datasource.DataSource = &ServerStartupDataSource{}
 
 // ServerStartupDataSource fetches startup command and variables for a server.
 type ServerStartupDataSource struct {
-	client *Client
+	dataSourceBase
 }
 
 // startupModel holds the data source state.
@@ -24,11 +24,12 @@ type startupModel struct {
 	EggID          types.Int64  `tfsdk:"egg_id"`
 	DockerImage    types.String `tfsdk:"docker_image"`
 	// Dynamic list of environment variables
-	Environment types.Map `tfsdk:"environment"`
+	Environment types.Map    `tfsdk:"environment"`
+	Retry       types.Object `tfsdk:"retry"`
 }
 
 func NewServerStartupDataSource() datasource.DataSource {
-	return &ServerStartupDataSource{}
+	return &ServerStartupDataSource{dataSourceBase: newDataSourceBase("kineticpanel_server_startup", scopeClient)}
 }
 
 func (d *ServerStartupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -60,28 +61,15 @@ func (d *ServerStartupDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				Computed:    true,
 				Description: "Map of environment variables passed to the startup process.",
 			},
+			"retry": retryAttribute(),
 		},
 	}
 }
 
-func (d *ServerStartupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(*Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
-		)
-		return
-	}
-	d.client = client
-}
-
 func (d *ServerStartupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var config struct {
 		ServerID types.String `tfsdk:"server_id"`
+		Retry    types.Object `tfsdk:"retry"`
 	}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
@@ -91,7 +79,11 @@ func (d *ServerStartupDataSource) Read(ctx context.Context, req datasource.ReadR
 	serverID := config.ServerID.ValueString()
 	path := "/servers/" + serverID + "/startup"
 
-	body, err := d.client.Get(path)
+	var body []byte
+	err := withRetry(ctx, config.Retry, func() (err error) {
+		body, err = d.client.Get(path)
+		return err
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch startup for server %s: %v", serverID, err))
 		return
@@ -110,11 +102,7 @@ func (d *ServerStartupDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	// Convert map[string]string → types.Map
-	envMap := make(map[string]types.String)
-	for k, v := range apiResp.Environment {
-		envMap[k] = types.StringValue(v)
-	}
-	env, diags := types.MapValueFrom(ctx, types.StringType, envMap)
+	env, diags := stringMapValue(apiResp.Environment)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -126,6 +114,7 @@ func (d *ServerStartupDataSource) Read(ctx context.Context, req datasource.ReadR
 		EggID:          types.Int64Value(apiResp.Egg),
 		DockerImage:    types.StringValue(apiResp.DockerImage),
 		Environment:    env,
+		Retry:          config.Retry,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServerStatusDataSource{}
+
+// ServerStatusDataSource fetches only the power state of a server.
+type ServerStatusDataSource struct {
+	dataSourceBase
+}
+
+// serverStatusModel holds the data source state.
+type serverStatusModel struct {
+	ServerID    types.String `tfsdk:"server_id"`
+	State       types.String `tfsdk:"state"` // running, offline, etc.
+	IsSuspended types.Bool   `tfsdk:"is_suspended"`
+}
+
+func NewServerStatusDataSource() datasource.DataSource {
+	return &ServerStatusDataSource{dataSourceBase: newDataSourceBase("kineticpanel_server_status", scopeClient)}
+}
+
+func (d *ServerStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_status"
+}
+
+func (d *ServerStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches only the power state of a Kinetic Panel server (Client API). " +
+			"It is one cheap request with no sampling or unit conversion, suitable for reading many servers per plan; use `kineticpanel_server_utilization` for usage figures.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "Power state: `running`, `starting`, `stopping` or `offline`.",
+			},
+			"is_suspended": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the server is suspended.",
+			},
+		},
+	}
+}
+
+func (d *ServerStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serverStatusModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := config.ServerID.ValueString()
+	status, err := fetchServerStatus(d.client, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch status for server %s: %v", serverID, err))
+		return
+	}
+
+	config.State = types.StringValue(status.CurrentState)
+	config.IsSuspended = types.BoolValue(status.IsSuspended)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// serverStatus is the part of the resources endpoint that describes the power state.
+type serverStatus struct {
+	CurrentState string `json:"current_state"`
+	IsSuspended  bool   `json:"is_suspended"`
+}
+
+// fetchServerStatus reads the power state of a server. Only the fields above
+// are decoded; the usage figures are skipped.
+func fetchServerStatus(client *Client, serverID string) (serverStatus, error) {
+	body, err := client.Get("/servers/" + serverID + "/resources")
+	if err != nil {
+		return serverStatus{}, err
+	}
+	var apiResp struct {
+		Attributes serverStatus `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return serverStatus{}, fmt.Errorf("JSON parse error: %w", err)
+	}
+	return apiResp.Attributes, nil
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// mockClient returns a Client API client answering from files, keyed by the
+// path below the panel host as in mock_responses_dir.
+func mockClient(t *testing.T, files map[string]string) *Client {
+	t.Helper()
+	dir := t.TempDir()
+	for name, body := range files {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	client := NewClient("https://panel.example.com", "mock", false)
+	client.httpClient.Transport = mockTransport{dir: dir}
+	return client
+}
+
+func TestResolveServerName(t *testing.T) {
+	client := mockClient(t, map[string]string{
+		"GET/api/client.json": `{
+			"data": [
+				{"attributes": {"identifier": "aaaa1111", "name": "Survival"}},
+				{"attributes": {"identifier": "bbbb2222", "name": "survival-test"}},
+				{"attributes": {"identifier": "cccc3333", "name": "Creative"}},
+				{"attributes": {"identifier": "dddd4444", "name": "Hub"}},
+				{"attributes": {"identifier": "eeee5555", "name": "Hub"}}
+			],
+			"meta": {"pagination": {"total_pages": 1}}
+		}`,
+	})
+	tests := []struct {
+		name     string
+		want     string
+		wantErr  string // substring of the error; empty for a match
+		notFound bool
+	}{
+		{name: "Survival", want: "aaaa1111"},
+		{name: "survival-", want: "bbbb2222"},
+		{name: "cre", want: "cccc3333"},
+		{name: "CREATIVE", want: "cccc3333"},
+		{name: "surv", wantErr: "2 servers match"},
+		{name: "survival", wantErr: "2 servers match"},
+		{name: "Hub", wantErr: "dddd4444 (Hub), eeee5555 (Hub)"},
+		{name: "Lobby", wantErr: "no server found", notFound: true},
+	}
+	for _, tt := range tests {
+		got, err := resolveServerName(client, tt.name)
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("resolveServerName(%q): %v", tt.name, err)
+		case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+			t.Errorf("resolveServerName(%q) error = %v, want one containing %q", tt.name, err, tt.wantErr)
+		case got != tt.want:
+			t.Errorf("resolveServerName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+		if err != nil && errors.Is(err, errServerNameNotFound) != tt.notFound {
+			t.Errorf("resolveServerName(%q): errors.Is(err, errServerNameNotFound) = %v, want %v", tt.name, !tt.notFound, tt.notFound)
+		}
+	}
+
+	// A failed listing is not "not found", so fail_if_missing = false does not hide it.
+	_, err := resolveServerName(mockClient(t, nil), "Survival")
+	if err == nil || errors.Is(err, errServerNameNotFound) {
+		t.Errorf("resolveServerName() with a failing API: error = %v, want a listing error", err)
+	}
+}
@@ -4,9 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -14,25 +20,50 @@ var _ datasource.DataSource = &ServerUtilizationDataSource{}
 
 // ServerUtilizationDataSource fetches real-time resource usage of a server.
 type ServerUtilizationDataSource struct {
-	client *Client
+	dataSourceBase
 }
 
 // utilizationModel holds the data source state.
 type utilizationModel struct {
-	ServerID  types.String `tfsdk:"server_id"`
-	State     types.String `tfsdk:"state"`        // running, offline, etc.
-	CPU       types.Int64  `tfsdk:"cpu_percent"`  // % of allocated CPU
-	Memory    types.Int64  `tfsdk:"memory_bytes"` // current usage
-	MemoryMB  types.Int64  `tfsdk:"memory_mb"`    // computed for convenience
-	Disk      types.Int64  `tfsdk:"disk_bytes"`
-	DiskMB    types.Int64  `tfsdk:"disk_mb"`
-	NetworkRX types.Int64  `tfsdk:"network_rx_bytes"`
-	NetworkTX types.Int64  `tfsdk:"network_tx_bytes"`
-	Uptime    types.Int64  `tfsdk:"uptime_seconds"`
+	ServerID  types.String  `tfsdk:"server_id"`
+	State     types.String  `tfsdk:"state"`        // running, offline, etc.
+	CPU       types.Float64 `tfsdk:"cpu_percent"`  // % of allocated CPU
+	Memory    types.Int64   `tfsdk:"memory_bytes"` // current usage
+	MemoryMB  types.Float64 `tfsdk:"memory_mb"`    // computed for convenience
+	Disk      types.Int64   `tfsdk:"disk_bytes"`
+	DiskMB    types.Float64 `tfsdk:"disk_mb"`
+	NetworkRX types.Int64   `tfsdk:"network_rx_bytes"`
+	NetworkTX types.Int64   `tfsdk:"network_tx_bytes"`
+	Uptime    types.Int64   `tfsdk:"uptime_seconds"`
+	// Sampling over time; instantaneous fields above report the last sample.
+	Samples       types.Int64  `tfsdk:"samples"`
+	Interval      types.Int64  `tfsdk:"interval_seconds"`
+	CPUStats      types.Object `tfsdk:"cpu_percent_stats"`
+	MemoryMBStats types.Object `tfsdk:"memory_mb_stats"`
+	DiskMBStats   types.Object `tfsdk:"disk_mb_stats"`
+}
+
+// utilizationSample is one decoded reading of the utilization endpoint.
+type utilizationSample struct {
+	State   string  `json:"state"`
+	Memory  int64   `json:"memory"`
+	CPU     float64 `json:"cpu"`
+	Disk    int64   `json:"disk"`
+	Network struct {
+		RX int64 `json:"rx"`
+		TX int64 `json:"tx"`
+	} `json:"network"`
+	Uptime int64 `json:"uptime"`
+}
+
+var statsAttrTypes = map[string]attr.Type{
+	"min": types.Float64Type,
+	"avg": types.Float64Type,
+	"max": types.Float64Type,
 }
 
 func NewServerUtilizationDataSource() datasource.DataSource {
-	return &ServerUtilizationDataSource{}
+	return &ServerUtilizationDataSource{dataSourceBase: newDataSourceBase("kineticpanel_server_utilization", scopeClient)}
 }
 
 func (d *ServerUtilizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,7 +72,7 @@ func (d *ServerUtilizationDataSource) Metadata(_ context.Context, req datasource
 
 func (d *ServerUtilizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches real-time resource utilization for a Kinetic Panel server (Client API).",
+		Description: "Fetches resource utilization for a Kinetic Panel server (Client API). Set `samples` to poll several times and get min/avg/max.",
 		Attributes: map[string]schema.Attribute{
 			"server_id": schema.StringAttribute{
 				Required:    true,
@@ -51,15 +82,15 @@ func (d *ServerUtilizationDataSource) Schema(_ context.Context, _ datasource.Sch
 				Computed:    true,
 				Description: "Current server state: `running`, `starting`, `stopping`, `offline`.",
 			},
-			"cpu_percent": schema.Int64Attribute{
+			"cpu_percent": schema.Float64Attribute{
 				Computed:    true,
-				Description: "Current CPU usage as percentage of allocated limit.",
+				Description: "Current CPU usage as percentage of allocated limit (rounded to 2 decimals).",
 			},
 			"memory_bytes": schema.Int64Attribute{
 				Computed:    true,
 				Description: "Current memory usage in bytes.",
 			},
-			"memory_mb": schema.Int64Attribute{
+			"memory_mb": schema.Float64Attribute{
 				Computed:    true,
 				Description: "Current memory usage in MB (rounded to 2 decimals).",
 			},
@@ -67,7 +98,7 @@ func (d *ServerUtilizationDataSource) Schema(_ context.Context, _ datasource.Sch
 				Computed:    true,
 				Description: "Current disk usage in bytes.",
 			},
-			"disk_mb": schema.Int64Attribute{
+			"disk_mb": schema.Float64Attribute{
 				Computed:    true,
 				Description: "Current disk usage in MB (rounded to 2 decimals).",
 			},
@@ -83,76 +114,150 @@ func (d *ServerUtilizationDataSource) Schema(_ context.Context, _ datasource.Sch
 				Computed:    true,
 				Description: "Server uptime in seconds.",
 			},
+			"samples": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of readings to take. Default: 1. Max: 60.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 60),
+				},
+			},
+			"interval_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Seconds to wait between readings when `samples` > 1. Default: 5.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 300),
+				},
+			},
+			"cpu_percent_stats": statsAttribute("CPU usage percentage across all samples."),
+			"memory_mb_stats":   statsAttribute("Memory usage in MB across all samples."),
+			"disk_mb_stats":     statsAttribute("Disk usage in MB across all samples."),
 		},
 	}
 }
 
-func (d *ServerUtilizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(*Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
-		)
-		return
-	}
-	d.client = client
-}
-
 func (d *ServerUtilizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config struct {
-		ServerID types.String `tfsdk:"server_id"`
-	}
+	var config utilizationModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	serverID := config.ServerID.ValueString()
-	path := "/servers/" + serverID + "/utilization"
-
-	body, err := d.client.Get(path)
-	if err != nil {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch utilization for server %s: %v", serverID, err))
-		return
+	samples := config.Samples.ValueInt64()
+	if samples == 0 {
+		samples = 1
+	}
+	interval := config.Interval.ValueInt64()
+	if interval == 0 {
+		interval = 5
 	}
 
-	var apiResp struct {
-		State   string `json:"state"`
-		Memory  int64  `json:"memory"`
-		CPU     int64  `json:"cpu"`
-		Disk    int64  `json:"disk"`
-		Network struct {
-			RX int64 `json:"rx"`
-			TX int64 `json:"tx"`
-		} `json:"network"`
-		Uptime int64 `json:"uptime"`
+	var last utilizationSample
+	var cpu, memMB, diskMB []float64
+	for i := int64(0); i < samples; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				resp.Diagnostics.AddError("Sampling cancelled", ctx.Err().Error())
+				return
+			case <-time.After(time.Duration(interval) * time.Second):
+			}
+		}
+
+		sample, err := fetchUtilization(d.client, serverID)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch utilization for server %s: %v", serverID, err))
+			return
+		}
+		last = sample
+		cpu = append(cpu, sample.CPU)
+		memMB = append(memMB, bytesToMB(sample.Memory))
+		diskMB = append(diskMB, bytesToMB(sample.Disk))
 	}
 
-	if err := json.Unmarshal(body, &apiResp); err != nil {
-		resp.Diagnostics.AddError("JSON Parse Error", err.Error())
+	cpuStats, diags := statsValue(cpu)
+	resp.Diagnostics.Append(diags...)
+	memStats, diags := statsValue(memMB)
+	resp.Diagnostics.Append(diags...)
+	diskStats, diags := statsValue(diskMB)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Convert bytes to MB with 2 decimal precision
-	memoryMB := apiResp.Memory / (1024 * 1024)
-	diskMB := apiResp.Disk / (1024 * 1024)
-
 	state := utilizationModel{
-		ServerID:  config.ServerID,
-		State:     types.StringValue(apiResp.State),
-		CPU:       types.Int64Value(apiResp.CPU),
-		Memory:    types.Int64Value(apiResp.Memory),
-		MemoryMB:  types.Int64Value(memoryMB),
-		Disk:      types.Int64Value(apiResp.Disk),
-		DiskMB:    types.Int64Value(diskMB),
-		NetworkRX: types.Int64Value(apiResp.Network.RX),
-		NetworkTX: types.Int64Value(apiResp.Network.TX),
-		Uptime:    types.Int64Value(apiResp.Uptime),
+		ServerID:      config.ServerID,
+		State:         types.StringValue(last.State),
+		CPU:           types.Float64Value(round2(last.CPU)),
+		Memory:        types.Int64Value(last.Memory),
+		MemoryMB:      types.Float64Value(bytesToMB(last.Memory)),
+		Disk:          types.Int64Value(last.Disk),
+		DiskMB:        types.Float64Value(bytesToMB(last.Disk)),
+		NetworkRX:     types.Int64Value(last.Network.RX),
+		NetworkTX:     types.Int64Value(last.Network.TX),
+		Uptime:        types.Int64Value(last.Uptime),
+		Samples:       config.Samples,
+		Interval:      config.Interval,
+		CPUStats:      cpuStats,
+		MemoryMBStats: memStats,
+		DiskMBStats:   diskStats,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// fetchUtilization takes a single utilization reading for a server.
+func fetchUtilization(client *Client, serverID string) (utilizationSample, error) {
+	var sample utilizationSample
+	body, err := client.Get("/servers/" + serverID + "/utilization")
+	if err != nil {
+		return sample, err
+	}
+	if err := json.Unmarshal(body, &sample); err != nil {
+		return sample, fmt.Errorf("JSON parse error: %w", err)
+	}
+	return sample, nil
+}
+
+func statsAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: description + " Rounded to 2 decimals.",
+		Attributes: map[string]schema.Attribute{
+			"min": schema.Float64Attribute{Computed: true},
+			"avg": schema.Float64Attribute{Computed: true},
+			"max": schema.Float64Attribute{Computed: true},
+		},
+	}
+}
+
+// statsValue summarises samples into a {min, avg, max} object.
+func statsValue(samples []float64) (types.Object, diag.Diagnostics) {
+	lo, hi, sum := math.Inf(1), math.Inf(-1), 0.0
+	for _, v := range samples {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+		sum += v
+	}
+	if len(samples) == 0 {
+		lo, hi = 0, 0
+	}
+	avg := 0.0
+	if len(samples) > 0 {
+		avg = sum / float64(len(samples))
+	}
+	return types.ObjectValue(statsAttrTypes, map[string]attr.Value{
+		"min": types.Float64Value(round2(lo)),
+		"avg": types.Float64Value(round2(avg)),
+		"max": types.Float64Value(round2(hi)),
+	})
+}
+
+// bytesToMB converts a byte count to MB, rounded to 2 decimals.
+func bytesToMB(b int64) float64 {
+	return round2(float64(b) / (1024 * 1024))
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
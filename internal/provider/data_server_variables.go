@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServerVariablesDataSource{}
+
+// ServerVariablesDataSource lists the startup variables of a server with their egg metadata.
+type ServerVariablesDataSource struct {
+	dataSourceBase
+}
+
+// serverVariablesModel holds the data source state.
+type serverVariablesModel struct {
+	ServerID  types.String `tfsdk:"server_id"`
+	Variables types.List   `tfsdk:"variables"`
+	Editable  types.Map    `tfsdk:"editable"` // env_variable -> server_value, editable only
+	Retry     types.Object `tfsdk:"retry"`
+}
+
+var serverVariableAttrTypes = map[string]attr.Type{
+	"name":          types.StringType,
+	"description":   types.StringType,
+	"env_variable":  types.StringType,
+	"default_value": types.StringType,
+	"server_value":  types.StringType,
+	"rules":         types.StringType,
+	"is_editable":   types.BoolType,
+}
+
+func NewServerVariablesDataSource() datasource.DataSource {
+	return &ServerVariablesDataSource{dataSourceBase: newDataSourceBase("kineticpanel_server_variables", scopeClient)}
+}
+
+func (d *ServerVariablesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_variables"
+}
+
+func (d *ServerVariablesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the startup variables of a Kinetic Panel server that the API key's user can see, with the egg's metadata (Client API). " +
+			"Use it to validate inputs and to only set variables the key is allowed to edit.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"variables": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Visible variables in egg order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name from the egg.",
+						},
+						"description": schema.StringAttribute{Computed: true},
+						"env_variable": schema.StringAttribute{
+							Computed:    true,
+							Description: "Environment variable key, as used by `kineticpanel_server_startup_variable`.",
+						},
+						"default_value": schema.StringAttribute{Computed: true},
+						"server_value": schema.StringAttribute{
+							Computed:    true,
+							Description: "Current value on this server.",
+						},
+						"rules": schema.StringAttribute{
+							Computed:    true,
+							Description: "Laravel validation rules the panel applies to new values (e.g. `required|string|max:20`).",
+						},
+						"is_editable": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the API key's user may change the value.",
+						},
+					},
+				},
+			},
+			"editable": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Current values of the editable variables, keyed by environment variable.",
+			},
+			"retry": retryAttribute(),
+		},
+	}
+}
+
+func (d *ServerVariablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serverVariablesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := config.ServerID.ValueString()
+	var vars []clientStartupVariable
+	err := withRetry(ctx, config.Retry, func() (err error) {
+		vars, err = listStartupVariables(d.client, serverID)
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch startup variables for server %s: %v", serverID, err))
+		return
+	}
+
+	items := []attr.Value{}
+	editable := map[string]string{}
+	for _, v := range vars {
+		obj, diags := types.ObjectValue(serverVariableAttrTypes, map[string]attr.Value{
+			"name":          types.StringValue(v.Name),
+			"description":   types.StringValue(v.Description),
+			"env_variable":  types.StringValue(v.EnvVariable),
+			"default_value": types.StringValue(v.DefaultValue),
+			"server_value":  types.StringValue(v.ServerValue),
+			"rules":         types.StringValue(v.Rules),
+			"is_editable":   types.BoolValue(v.IsEditable),
+		})
+		resp.Diagnostics.Append(diags...)
+		items = append(items, obj)
+		if v.IsEditable {
+			editable[v.EnvVariable] = v.ServerValue
+		}
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: serverVariableAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	editableMap, diags := stringMapValue(editable)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Variables = list
+	config.Editable = editableMap
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ServersDataSource{}
+
+// ServersDataSource lists the servers visible to the configured key, with optional filters.
+type ServersDataSource struct {
+	dataSourceBase
+}
+
+// serversModel holds the data source state.
+type serversModel struct {
+	NameContains types.String `tfsdk:"name_contains"`
+	Node         types.String `tfsdk:"node"`
+	Suspended    types.Bool   `tfsdk:"is_suspended"`
+	EggFeature   types.String `tfsdk:"egg_feature"`
+	Include      types.List   `tfsdk:"include"`
+
+	Servers      []clientServerModel          `tfsdk:"servers"`
+	ByIdentifier map[string]clientServerModel `tfsdk:"servers_by_identifier"`
+}
+
+func NewServersDataSource() datasource.DataSource {
+	return &ServersDataSource{dataSourceBase: newDataSourceBase("kineticpanel_servers", scopeClient)}
+}
+
+func (d *ServersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_servers"
+}
+
+func (d *ServersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Kinetic Panel servers (Client API), optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"name_contains": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return servers whose name contains this substring (case-insensitive). Passed to the panel as `filter[name]`.",
+			},
+			"node": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return servers running on the node with this name.",
+			},
+			"is_suspended": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return servers with this suspension state.",
+			},
+			"egg_feature": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return servers whose egg declares this feature (e.g. `eula` for Minecraft).",
+			},
+			"include": includeAttribute(),
+			"servers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Servers matching all of the configured filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serverComputedAttributes(),
+				},
+			},
+			"servers_by_identifier": schema.MapNestedAttribute{
+				Computed: true,
+				Description: "`servers` keyed by short identifier, for `for_each` without depending on list order: " +
+					"`for_each = data.kineticpanel_servers.all.servers_by_identifier`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serverComputedAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ServersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serversModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameFilter := strings.ToLower(config.NameContains.ValueString())
+	config.Servers = []clientServerModel{}
+	config.ByIdentifier = map[string]clientServerModel{}
+
+	// The panel applies the name filter as a partial match; it is re-checked below.
+	include, diags := includeQuery(ctx, config.Include)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	list, err := listClientServers(d.client, config.NameContains.ValueString(), include)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list servers: %v", err))
+		return
+	}
+
+	for _, a := range list {
+		// ----- client-side filters ----------------------------------------
+		if nameFilter != "" && !strings.Contains(strings.ToLower(a.Name), nameFilter) {
+			continue
+		}
+		if !config.Node.IsNull() && a.Node != config.Node.ValueString() {
+			continue
+		}
+		if !config.Suspended.IsNull() && a.IsSuspended != config.Suspended.ValueBool() {
+			continue
+		}
+		if !config.EggFeature.IsNull() && !slices.Contains(a.EggFeatures, config.EggFeature.ValueString()) {
+			continue
+		}
+
+		server := flattenClientServer(a)
+		config.Servers = append(config.Servers, server)
+		config.ByIdentifier[a.Identifier] = server
+	}
+	if DebugEnabled {
+		tflog.Debug(ctx, "Servers listed", map[string]any{"count": len(config.Servers)})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
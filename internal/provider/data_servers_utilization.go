@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServersUtilizationDataSource{}
+
+// ServersUtilizationDataSource fetches resource usage of many servers at once.
+type ServersUtilizationDataSource struct {
+	dataSourceBase
+}
+
+// serversUtilizationModel holds the data source state.
+type serversUtilizationModel struct {
+	ServerIDs    types.List   `tfsdk:"server_ids"`
+	NameContains types.String `tfsdk:"name_contains"`
+	Node         types.String `tfsdk:"node"`
+	Concurrency  types.Int64  `tfsdk:"concurrency"` // default 8
+	Servers      types.Map    `tfsdk:"servers"`
+	Errors       types.Map    `tfsdk:"errors"`
+}
+
+var serverUsageAttrTypes = map[string]attr.Type{
+	"state":            types.StringType,
+	"cpu_percent":      types.Float64Type,
+	"memory_bytes":     types.Int64Type,
+	"memory_mb":        types.Float64Type,
+	"disk_bytes":       types.Int64Type,
+	"disk_mb":          types.Float64Type,
+	"network_rx_bytes": types.Int64Type,
+	"network_tx_bytes": types.Int64Type,
+	"uptime_seconds":   types.Int64Type,
+}
+
+func NewServersUtilizationDataSource() datasource.DataSource {
+	return &ServersUtilizationDataSource{dataSourceBase: newDataSourceBase("kineticpanel_servers_utilization", scopeClient)}
+}
+
+func (d *ServersUtilizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_servers_utilization"
+}
+
+func (d *ServersUtilizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches current resource utilization for many Kinetic Panel servers at once (Client API), keyed by identifier. " +
+			"Servers are read concurrently, which replaces one `kineticpanel_server_utilization` per server in dashboards.",
+		Attributes: map[string]schema.Attribute{
+			"server_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("name_contains"), path.MatchRoot("node")),
+					listvalidator.UniqueValues(),
+				},
+				Description: "Short identifiers of the servers to read. Default: every server visible to the key, narrowed by `name_contains` and `node`.",
+			},
+			"name_contains": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only read servers whose name contains this substring (case-insensitive).",
+			},
+			"node": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only read servers running on the node with this name.",
+			},
+			"concurrency": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32),
+				},
+				Description: "Number of servers read in parallel. Default: 8.",
+			},
+			"servers": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "Utilization by server identifier. Fields match `kineticpanel_server_utilization`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"state":            schema.StringAttribute{Computed: true},
+						"cpu_percent":      schema.Float64Attribute{Computed: true},
+						"memory_bytes":     schema.Int64Attribute{Computed: true},
+						"memory_mb":        schema.Float64Attribute{Computed: true},
+						"disk_bytes":       schema.Int64Attribute{Computed: true},
+						"disk_mb":          schema.Float64Attribute{Computed: true},
+						"network_rx_bytes": schema.Int64Attribute{Computed: true},
+						"network_tx_bytes": schema.Int64Attribute{Computed: true},
+						"uptime_seconds":   schema.Int64Attribute{Computed: true},
+					},
+				},
+			},
+			"errors": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Error by server identifier for servers that could not be read (e.g. suspended, or on an unreachable node). They are left out of `servers`.",
+			},
+		},
+	}
+}
+
+func (d *ServersUtilizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serversUtilizationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+	if !config.ServerIDs.IsNull() {
+		resp.Diagnostics.Append(config.ServerIDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		list, err := listClientServers(d.client, config.NameContains.ValueString(), "")
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list servers: %v", err))
+			return
+		}
+		nameFilter := strings.ToLower(config.NameContains.ValueString())
+		for _, a := range list {
+			if nameFilter != "" && !strings.Contains(strings.ToLower(a.Name), nameFilter) {
+				continue
+			}
+			if !config.Node.IsNull() && a.Node != config.Node.ValueString() {
+				continue
+			}
+			ids = append(ids, a.Identifier)
+		}
+	}
+
+	workers := 8
+	if !config.Concurrency.IsNull() {
+		workers = int(config.Concurrency.ValueInt64())
+	}
+	samples, errs := fetchUtilizations(ctx, d.client, ids, workers)
+	if ctx.Err() != nil {
+		resp.Diagnostics.AddError("Read cancelled", ctx.Err().Error())
+		return
+	}
+
+	servers := map[string]attr.Value{}
+	errors := map[string]attr.Value{}
+	for i, id := range ids {
+		if errs[i] != nil {
+			errors[id] = types.StringValue(errs[i].Error())
+			continue
+		}
+		s := samples[i]
+		obj, diags := types.ObjectValue(serverUsageAttrTypes, map[string]attr.Value{
+			"state":            types.StringValue(s.State),
+			"cpu_percent":      types.Float64Value(round2(s.CPU)),
+			"memory_bytes":     types.Int64Value(s.Memory),
+			"memory_mb":        types.Float64Value(bytesToMB(s.Memory)),
+			"disk_bytes":       types.Int64Value(s.Disk),
+			"disk_mb":          types.Float64Value(bytesToMB(s.Disk)),
+			"network_rx_bytes": types.Int64Value(s.Network.RX),
+			"network_tx_bytes": types.Int64Value(s.Network.TX),
+			"uptime_seconds":   types.Int64Value(s.Uptime),
+		})
+		resp.Diagnostics.Append(diags...)
+		servers[id] = obj
+	}
+	if len(errors) > 0 {
+		resp.Diagnostics.AddWarning("Some Servers Not Read",
+			fmt.Sprintf("Utilization of %d of %d servers could not be fetched; see the `errors` attribute.", len(errors), len(ids)))
+	}
+
+	serverMap, diags := types.MapValue(types.ObjectType{AttrTypes: serverUsageAttrTypes}, servers)
+	resp.Diagnostics.Append(diags...)
+	errorMap, diags := types.MapValue(types.StringType, errors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Servers = serverMap
+	config.Errors = errorMap
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// fetchUtilizations reads the utilization of each server with at most
+// workers requests in flight. Results and errors are indexed like ids.
+func fetchUtilizations(ctx context.Context, client *Client, ids []string, workers int) ([]utilizationSample, []error) {
+	samples := make([]utilizationSample, len(ids))
+	errs := make([]error, len(ids))
+
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(ids); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				samples[i], errs[i] = fetchUtilization(client, ids[i])
+			}
+		}()
+	}
+
+feed:
+	for i := range ids {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+	return samples, errs
+}
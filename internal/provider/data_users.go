@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &UsersDataSource{}
+
+// UsersDataSource lists panel users, filtered and sorted by the panel.
+type UsersDataSource struct {
+	dataSourceBase
+}
+
+// usersModel holds the data source state.
+type usersModel struct {
+	Filter    types.Map    `tfsdk:"filter"`
+	Sort      types.String `tfsdk:"sort"`
+	RootAdmin types.Bool   `tfsdk:"root_admin"`
+	Users     types.List   `tfsdk:"users"`
+}
+
+// appUser is a user as returned by the Application API.
+type appUser struct {
+	ID         int64   `json:"id"`
+	ExternalID *string `json:"external_id"`
+	UUID       string  `json:"uuid"`
+	Username   string  `json:"username"`
+	Email      string  `json:"email"`
+	FirstName  string  `json:"first_name"`
+	LastName   string  `json:"last_name"`
+	Language   string  `json:"language"`
+	RootAdmin  bool    `json:"root_admin"`
+	TwoFactor  bool    `json:"2fa"`
+}
+
+var userAttrTypes = map[string]attr.Type{
+	"id":                 types.Int64Type,
+	"external_id":        types.StringType,
+	"uuid":               types.StringType,
+	"username":           types.StringType,
+	"email":              types.StringType,
+	"first_name":         types.StringType,
+	"last_name":          types.StringType,
+	"language":           types.StringType,
+	"root_admin":         types.BoolType,
+	"two_factor_enabled": types.BoolType,
+}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{dataSourceBase: newDataSourceBase("kineticpanel_users", scopeApplication)}
+}
+
+func (d *UsersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists panel users (Application API). `filter` and `sort` are applied by the panel, so looking a user up by email or uuid reads a single page. " +
+			"`root_admin` is applied by the provider after listing, e.g. `root_admin = true` to enumerate administrators for a security review.",
+		Attributes: map[string]schema.Attribute{
+			"filter": appFilterAttribute("email", "uuid", "username", "external_id"),
+			"sort":   appSortAttribute("id", "uuid"),
+			"root_admin": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return users with this root admin state. The panel cannot filter on it, so every user matching `filter` is listed.",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Users matching `filter` and `root_admin`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                 schema.Int64Attribute{Computed: true},
+						"external_id":        schema.StringAttribute{Computed: true},
+						"uuid":               schema.StringAttribute{Computed: true},
+						"username":           schema.StringAttribute{Computed: true},
+						"email":              schema.StringAttribute{Computed: true},
+						"first_name":         schema.StringAttribute{Computed: true},
+						"last_name":          schema.StringAttribute{Computed: true},
+						"language":           schema.StringAttribute{Computed: true},
+						"root_admin":         schema.BoolAttribute{Computed: true},
+						"two_factor_enabled": schema.BoolAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config usersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users := []attr.Value{}
+	err := listAppPages(d.client, "/users", appListOptionsFrom(config.Filter, config.Sort), func(raw json.RawMessage) error {
+		var u appUser
+		if err := json.Unmarshal(raw, &u); err != nil {
+			return fmt.Errorf("JSON parse error: %w", err)
+		}
+		if !config.RootAdmin.IsNull() && u.RootAdmin != config.RootAdmin.ValueBool() {
+			return nil
+		}
+		obj, diags := types.ObjectValue(userAttrTypes, map[string]attr.Value{
+			"id":                 types.Int64Value(u.ID),
+			"external_id":        types.StringPointerValue(u.ExternalID),
+			"uuid":               types.StringValue(u.UUID),
+			"username":           types.StringValue(u.Username),
+			"email":              types.StringValue(u.Email),
+			"first_name":         types.StringValue(u.FirstName),
+			"last_name":          types.StringValue(u.LastName),
+			"language":           types.StringValue(u.Language),
+			"root_admin":         types.BoolValue(u.RootAdmin),
+			"two_factor_enabled": types.BoolValue(u.TwoFactor),
+		})
+		resp.Diagnostics.Append(diags...)
+		users = append(users, obj)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list users: %v", err))
+		return
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: userAttrTypes}, users)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Users = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// Helpers around database hosts on the Application API.
+
+// databaseHost is a database host as returned by the Application API. Hosts
+// linked to a node have node_id set; Pelican-based panels link a host to
+// several nodes through node_ids instead.
+type databaseHost struct {
+	ID           int64   `json:"id"`
+	Name         string  `json:"name"`
+	Host         string  `json:"host"`
+	Port         int64   `json:"port"`
+	Username     string  `json:"username"`
+	MaxDatabases *int64  `json:"max_databases"`
+	NodeID       *int64  `json:"node_id"`
+	NodeIDs      []int64 `json:"node_ids"`
+}
+
+// linkedTo reports whether the host is linked to nodeID.
+func (h databaseHost) linkedTo(nodeID int64) bool {
+	return (h.NodeID != nil && *h.NodeID == nodeID) || slices.Contains(h.NodeIDs, nodeID)
+}
+
+// getDatabaseHost fetches a database host.
+func getDatabaseHost(client *Client, hostID int64) (databaseHost, error) {
+	body, err := client.Get(fmt.Sprintf("/database-hosts/%d", hostID))
+	if err != nil {
+		return databaseHost{}, err
+	}
+	return parseDatabaseHost(body)
+}
+
+// parseDatabaseHost decodes a database host response.
+func parseDatabaseHost(body []byte) (databaseHost, error) {
+	var apiResp struct {
+		Attributes databaseHost `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return databaseHost{}, fmt.Errorf("JSON parse error: %w", err)
+	}
+	return apiResp.Attributes, nil
+}
+
+// listDatabaseHosts returns every database host, walking all pages.
+func listDatabaseHosts(client *Client) ([]databaseHost, error) {
+	var hosts []databaseHost
+	err := listAppPages(client, "/database-hosts", appListOptions{}, func(raw json.RawMessage) error {
+		var h databaseHost
+		if err := json.Unmarshal(raw, &h); err != nil {
+			return err
+		}
+		hosts = append(hosts, h)
+		return nil
+	})
+	return hosts, err
+}
+
+// nodeDatabaseHost returns the database host linked to nodeID. With several,
+// the one with the lowest ID wins so the choice is stable.
+func nodeDatabaseHost(client *Client, nodeID int64) (databaseHost, error) {
+	hosts, err := listDatabaseHosts(client)
+	if err != nil {
+		return databaseHost{}, err
+	}
+	var found *databaseHost
+	for i, h := range hosts {
+		if h.linkedTo(nodeID) && (found == nil || h.ID < found.ID) {
+			found = &hosts[i]
+		}
+	}
+	if found == nil {
+		return databaseHost{}, fmt.Errorf("no database host is linked to node %d", nodeID)
+	}
+	return *found, nil
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Helpers around server databases on the Application API.
+
+// appServerDatabase is a server database as returned by the Application API
+// (with `include=host`, and `include=password` for the password).
+type appServerDatabase struct {
+	ID             int64  `json:"id"`
+	Server         int64  `json:"server"`
+	Host           int64  `json:"host"`
+	Database       string `json:"database"`
+	Username       string `json:"username"`
+	Remote         string `json:"remote"`
+	MaxConnections int64  `json:"max_connections"`
+	CreatedAt      string `json:"created_at"`
+	Relationships  struct {
+		Host struct {
+			Attributes struct {
+				ID   int64  `json:"id"`
+				Name string `json:"name"`
+				Host string `json:"host"`
+				Port int64  `json:"port"`
+			} `json:"attributes"`
+		} `json:"host"`
+		Password struct {
+			Attributes struct {
+				Password string `json:"password"`
+			} `json:"attributes"`
+		} `json:"password"`
+	} `json:"relationships"`
+}
+
+// listAppServerDatabases returns the databases of a server with their host.
+func listAppServerDatabases(client *Client, serverID int64) ([]appServerDatabase, error) {
+	body, err := client.Get(fmt.Sprintf("/servers/%d/databases?include=host", serverID))
+	if err != nil {
+		return nil, err
+	}
+	var apiResp struct {
+		Data []struct {
+			Attributes appServerDatabase `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, err
+	}
+	dbs := make([]appServerDatabase, 0, len(apiResp.Data))
+	for _, d := range apiResp.Data {
+		dbs = append(dbs, d.Attributes)
+	}
+	return dbs, nil
+}
+
+// listAppServerIDs returns the IDs of every server on the panel, walking all pages.
+func listAppServerIDs(client *Client) ([]int64, error) {
+	var ids []int64
+	err := listAppPages(client, "/servers", appListOptions{}, func(raw json.RawMessage) error {
+		var s struct {
+			ID int64 `json:"id"`
+		}
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+		ids = append(ids, s.ID)
+		return nil
+	})
+	return ids, err
+}
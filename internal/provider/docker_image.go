@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// imageRepositoryPattern matches the repository path of an image reference:
+// lowercase components separated by `/`.
+var imageRepositoryPattern = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+
+// normalizeImage returns the fully qualified form of a Docker image
+// reference, as Docker resolves it: `java` becomes
+// `docker.io/library/java:latest` and `index.docker.io` becomes `docker.io`.
+// References with a digest get no default tag.
+func normalizeImage(ref string) (string, error) {
+	if ref == "" || strings.ContainsAny(ref, " \t\n") {
+		return "", fmt.Errorf("%q is not an image reference", ref)
+	}
+
+	name, digest, hasDigest := strings.Cut(ref, "@")
+	if hasDigest && !strings.Contains(digest, ":") {
+		return "", fmt.Errorf("%q has an invalid digest %q", ref, digest)
+	}
+
+	tag := ""
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+		if tag == "" {
+			return "", fmt.Errorf("%q has an empty tag", ref)
+		}
+	}
+
+	domain, repo := "docker.io", name
+	if first, rest, ok := strings.Cut(name, "/"); ok && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		domain, repo = first, rest
+	}
+	if domain == "index.docker.io" || domain == "registry-1.docker.io" {
+		domain = "docker.io"
+	}
+	if domain == "docker.io" && !strings.Contains(repo, "/") {
+		repo = "library/" + repo
+	}
+	if !imageRepositoryPattern.MatchString(repo) {
+		return "", fmt.Errorf("%q has an invalid repository %q; repositories are lowercase", ref, repo)
+	}
+
+	out := domain + "/" + repo
+	if tag != "" {
+		out += ":" + tag
+	} else if !hasDigest {
+		out += ":latest"
+	}
+	if hasDigest {
+		out += "@" + digest
+	}
+	return out, nil
+}
+
+// sameImage reports whether two image references name the same image. Invalid
+// references only equal themselves.
+func sameImage(a, b string) bool {
+	if a == b {
+		return true
+	}
+	na, errA := normalizeImage(a)
+	nb, errB := normalizeImage(b)
+	return errA == nil && errB == nil && na == nb
+}
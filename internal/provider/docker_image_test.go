@@ -0,0 +1,64 @@
+package provider
+
+import "testing"
+
+func TestNormalizeImage(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "java", want: "docker.io/library/java:latest"},
+		{in: "java:8", want: "docker.io/library/java:8"},
+		{in: "itzg/minecraft-server", want: "docker.io/itzg/minecraft-server:latest"},
+		{in: "docker.io/itzg/minecraft-server:java17", want: "docker.io/itzg/minecraft-server:java17"},
+		{in: "index.docker.io/library/java:8", want: "docker.io/library/java:8"},
+		{in: "registry-1.docker.io/java", want: "docker.io/library/java:latest"},
+		{in: "ghcr.io/pterodactyl/yolks:java_17", want: "ghcr.io/pterodactyl/yolks:java_17"},
+		{in: "ghcr.io/pterodactyl/yolks", want: "ghcr.io/pterodactyl/yolks:latest"},
+		{in: "registry.example.com:5000/team/app:1.0", want: "registry.example.com:5000/team/app:1.0"},
+		{in: "localhost/app", want: "localhost/app:latest"},
+		{in: "localhost:5000/app", want: "localhost:5000/app:latest"},
+		{in: "java@sha256:abc123", want: "docker.io/library/java@sha256:abc123"},
+		{in: "java:8@sha256:abc123", want: "docker.io/library/java:8@sha256:abc123"},
+		{in: "my_org/app.name__x", want: "docker.io/my_org/app.name__x:latest"},
+		{in: "", wantErr: true},
+		{in: "java 8", wantErr: true},
+		{in: "Java", wantErr: true},
+		{in: "ghcr.io/Pterodactyl/yolks", wantErr: true},
+		{in: "java:", wantErr: true},
+		{in: "java@sha256", wantErr: true},
+		{in: "ghcr.io/", wantErr: true},
+		{in: "-java", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := normalizeImage(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("normalizeImage(%q) error = %v, want error %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("normalizeImage(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSameImage(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"java", "docker.io/library/java:latest", true},
+		{"java:latest", "index.docker.io/library/java", true},
+		{"ghcr.io/pterodactyl/yolks:java_17", "ghcr.io/pterodactyl/yolks:java_17", true},
+		{"java:8", "java", false},
+		{"ghcr.io/pterodactyl/yolks:java_17", "docker.io/pterodactyl/yolks:java_17", false},
+		{"Not Valid", "Not Valid", true},
+		{"Not Valid", "not valid", false},
+	}
+	for _, tt := range tests {
+		if got := sameImage(tt.a, tt.b); got != tt.want {
+			t.Errorf("sameImage(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
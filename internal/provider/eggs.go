@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Helpers around eggs and server startup settings on the Application API.
+
+// eggVariable is one variable of an egg.
+type eggVariable struct {
+	Name         string  `json:"name"`
+	Description  string  `json:"description"`
+	EnvVariable  string  `json:"env_variable"`
+	DefaultValue *string `json:"default_value"`
+	UserViewable bool    `json:"user_viewable"`
+	UserEditable bool    `json:"user_editable"`
+	Rules        string  `json:"rules"`
+}
+
+// eggAttributes is an egg as returned by the Application API (with `include=variables`).
+type eggAttributes struct {
+	ID            int64             `json:"id"`
+	UUID          string            `json:"uuid"`
+	Name          string            `json:"name"`
+	Nest          int64             `json:"nest"`
+	Author        string            `json:"author"`
+	Description   string            `json:"description"`
+	DockerImage   string            `json:"docker_image"`
+	DockerImages  map[string]string `json:"docker_images"` // display name -> image
+	Startup       string            `json:"startup"`
+	Relationships struct {
+		Variables struct {
+			Data []struct {
+				Attributes eggVariable `json:"attributes"`
+			} `json:"data"`
+		} `json:"variables"`
+	} `json:"relationships"`
+}
+
+// variables flattens the variables relationship.
+func (e eggAttributes) variables() []eggVariable {
+	vars := make([]eggVariable, 0, len(e.Relationships.Variables.Data))
+	for _, v := range e.Relationships.Variables.Data {
+		vars = append(vars, v.Attributes)
+	}
+	return vars
+}
+
+// defaultImage returns the egg's default docker image. Newer panels only
+// fill docker_images; the entry with the first display name is used then.
+func (e eggAttributes) defaultImage() string {
+	if e.DockerImage != "" {
+		return e.DockerImage
+	}
+	names := make([]string, 0, len(e.DockerImages))
+	for name := range e.DockerImages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return ""
+	}
+	return e.DockerImages[names[0]]
+}
+
+// getEgg fetches an egg with its variables.
+func getEgg(client *Client, nestID, eggID int64) (eggAttributes, error) {
+	body, err := client.Get(fmt.Sprintf("/nests/%d/eggs/%d?include=variables", nestID, eggID))
+	if err != nil {
+		return eggAttributes{}, err
+	}
+	var apiResp struct {
+		Attributes eggAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return eggAttributes{}, err
+	}
+	return apiResp.Attributes, nil
+}
+
+// appServerStartup is the startup part of a server on the Application API.
+type appServerStartup struct {
+	Egg       int64 `json:"egg"`
+	Nest      int64 `json:"nest"`
+	Container struct {
+		StartupCommand string         `json:"startup_command"`
+		Image          string         `json:"image"`
+		Environment    map[string]any `json:"environment"`
+	} `json:"container"`
+}
+
+// environment returns the container environment with values as strings.
+// The panel mixes strings and numbers here.
+func (s appServerStartup) environment() map[string]string {
+	env := make(map[string]string, len(s.Container.Environment))
+	for k, v := range s.Container.Environment {
+		switch val := v.(type) {
+		case nil:
+			env[k] = ""
+		case string:
+			env[k] = val
+		case float64:
+			env[k] = strconv.FormatFloat(val, 'f', -1, 64)
+		default:
+			env[k] = fmt.Sprint(val)
+		}
+	}
+	return env
+}
+
+// getAppServerStartup fetches the egg, image, startup command and environment of a server.
+func getAppServerStartup(client *Client, serverID int64) (appServerStartup, error) {
+	body, err := client.Get("/servers/" + strconv.FormatInt(serverID, 10))
+	if err != nil {
+		return appServerStartup{}, err
+	}
+	var apiResp struct {
+		Attributes appServerStartup `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return appServerStartup{}, err
+	}
+	return apiResp.Attributes, nil
+}
+
+// updateAppServerStartup sets egg, image, startup command and environment in
+// one call. The panel validates environment against the egg's variable rules.
+func updateAppServerStartup(client *Client, serverID int64, egg int64, image, startup string, env map[string]string, skipScripts bool) error {
+	_, err := client.Patch("/servers/"+strconv.FormatInt(serverID, 10)+"/startup", map[string]any{
+		"egg":          egg,
+		"image":        image,
+		"startup":      startup,
+		"environment":  env,
+		"skip_scripts": skipScripts,
+	})
+	return err
+}
+
+// eggEnvironment builds a full environment for egg: values from overrides
+// first, then values the server already has, then the egg's defaults.
+// Keys that are not variables of egg are dropped.
+func eggEnvironment(egg eggAttributes, current, overrides map[string]string) map[string]string {
+	env := map[string]string{}
+	for _, v := range egg.variables() {
+		key := v.EnvVariable
+		if val, ok := overrides[key]; ok {
+			env[key] = val
+		} else if val, ok := current[key]; ok {
+			env[key] = val
+		} else if v.DefaultValue != nil {
+			env[key] = *v.DefaultValue
+		} else {
+			env[key] = ""
+		}
+	}
+	return env
+}
+
+// findEgg looks an egg up by ID across all nests, for callers that do not know
+// its nest. Variables are not included.
+func findEgg(client *Client, eggID int64) (eggAttributes, error) {
+	nests, err := listNests(client)
+	if err != nil {
+		return eggAttributes{}, err
+	}
+	for _, nest := range nests {
+		for _, egg := range nest.eggs() {
+			if egg.ID == eggID {
+				return egg, nil
+			}
+		}
+	}
+	return eggAttributes{}, fmt.Errorf("egg %d not found", eggID)
+}
+
+// nestAttributes is a nest as returned by the Application API (with `include=eggs`).
+type nestAttributes struct {
+	ID            int64  `json:"id"`
+	UUID          string `json:"uuid"`
+	Author        string `json:"author"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Relationships struct {
+		Eggs struct {
+			Data []struct {
+				Attributes eggAttributes `json:"attributes"`
+			} `json:"data"`
+		} `json:"eggs"`
+	} `json:"relationships"`
+}
+
+// eggs flattens the eggs relationship.
+func (n nestAttributes) eggs() []eggAttributes {
+	eggs := make([]eggAttributes, 0, len(n.Relationships.Eggs.Data))
+	for _, e := range n.Relationships.Eggs.Data {
+		eggs = append(eggs, e.Attributes)
+	}
+	return eggs
+}
+
+// listNests returns every nest with its eggs (without variables).
+func listNests(client *Client) ([]nestAttributes, error) {
+	var nests []nestAttributes
+	err := listAppPages(client, "/nests", appListOptions{Include: "eggs"}, func(raw json.RawMessage) error {
+		var n nestAttributes
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return err
+		}
+		nests = append(nests, n)
+		return nil
+	})
+	return nests, err
+}
+
+// allowedImages returns the images an egg allows, including the legacy
+// single docker_image field.
+func (e eggAttributes) allowedImages() map[string]string {
+	images := map[string]string{}
+	for name, img := range e.DockerImages {
+		images[name] = img
+	}
+	if e.DockerImage != "" && len(images) == 0 {
+		images[e.DockerImage] = e.DockerImage
+	}
+	return images
+}
+
+// warnUnlistedImage adds a warning when image is not one of allowed. An empty
+// allowed list means the egg does not restrict images.
+func warnUnlistedImage(diags *diag.Diagnostics, p path.Path, image string, allowed map[string]string, owner string) {
+	if len(allowed) == 0 {
+		return
+	}
+	var list []string
+	for _, img := range allowed {
+		if sameImage(img, image) {
+			return
+		}
+		list = append(list, img)
+	}
+	sort.Strings(list)
+	diags.AddAttributeWarning(p, "Docker Image Not Allowed By Egg",
+		fmt.Sprintf("%s does not list %q as an allowed image, so the server may fail to boot or the panel may reject it. Allowed images: %s",
+			owner, image, strings.Join(list, ", ")))
+}
@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Helpers around the Client API files endpoints, shared by every resource that
+// edits files inside a server's volume.
+
+// filesPath builds `/servers/{id}/files/{action}` with the given query values.
+func filesPath(serverID, action string, query url.Values) string {
+	pth := "/servers/" + serverID + "/files/" + action
+	if len(query) > 0 {
+		pth += "?" + query.Encode()
+	}
+	return pth
+}
+
+// normalizeServerPath makes a path absolute relative to the server root.
+func normalizeServerPath(file string) string {
+	return "/" + strings.TrimLeft(file, "/")
+}
+
+// readServerFile returns the contents of a file inside the server volume.
+func readServerFile(client *Client, serverID, file string) ([]byte, error) {
+	return client.Get(filesPath(serverID, "contents", url.Values{"file": {normalizeServerPath(file)}}))
+}
+
+// readServerFileLimited is readServerFile for files of unknown size: it fails
+// once more than limit bytes arrive instead of buffering the whole file.
+func readServerFileLimited(client *Client, serverID, file string, limit int64) ([]byte, error) {
+	return client.requestLimited(client.context(), "GET", filesPath(serverID, "contents", url.Values{"file": {normalizeServerPath(file)}}), nil, "", limit)
+}
+
+// writeServerFile creates or overwrites a file inside the server volume.
+func writeServerFile(client *Client, serverID, file string, content []byte) error {
+	_, err := client.PostRaw(filesPath(serverID, "write", url.Values{"file": {normalizeServerPath(file)}}), content, "text/plain")
+	return err
+}
+
+// serverFileObject is one entry of a directory listing.
+type serverFileObject struct {
+	Name       string `json:"name"`
+	Mode       string `json:"mode"`      // e.g. -rwxr-xr-x
+	ModeBits   string `json:"mode_bits"` // e.g. 755
+	Size       int64  `json:"size"`
+	IsFile     bool   `json:"is_file"`
+	IsSymlink  bool   `json:"is_symlink"`
+	Mimetype   string `json:"mimetype"`
+	CreatedAt  string `json:"created_at"`
+	ModifiedAt string `json:"modified_at"`
+}
+
+// listServerDirectory returns the entries of a directory inside the server volume.
+func listServerDirectory(client *Client, serverID, directory string) ([]serverFileObject, error) {
+	body, err := client.Get(filesPath(serverID, "list", url.Values{"directory": {normalizeServerPath(directory)}}))
+	if err != nil {
+		return nil, err
+	}
+	var apiResp struct {
+		Data []struct {
+			Attributes serverFileObject `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, err
+	}
+	objects := make([]serverFileObject, 0, len(apiResp.Data))
+	for _, d := range apiResp.Data {
+		objects = append(objects, d.Attributes)
+	}
+	return objects, nil
+}
+
+// statServerFile returns the directory entry of a file, found by listing its
+// parent directory, so the file itself is never read.
+func statServerFile(client *Client, serverID, file string) (serverFileObject, error) {
+	file = normalizeServerPath(file)
+	dir, name := path.Split(file)
+	objects, err := listServerDirectory(client, serverID, dir)
+	if err != nil {
+		return serverFileObject{}, err
+	}
+	for _, o := range objects {
+		if o.Name == name {
+			return o, nil
+		}
+	}
+	return serverFileObject{}, &APIError{StatusCode: http.StatusNotFound, Detail: "file not found", message: fmt.Sprintf("%s not found on server %s", file, serverID)}
+}
+
+// fileModeValidator accepts octal permission bits such as 755 or 0644.
+var fileModeValidator = stringvalidator.RegexMatches(regexp.MustCompile(`^0?[0-7]{3}$`), "must be octal permission bits, e.g. 755 or 0644")
+
+// chmodServerFile sets the permission bits of a file inside the server volume.
+func chmodServerFile(client *Client, serverID, file, mode string) error {
+	dir, name := path.Split(normalizeServerPath(file))
+	_, err := client.Post(filesPath(serverID, "chmod", nil), map[string]any{
+		"root":  dir,
+		"files": []map[string]string{{"file": name, "mode": strings.TrimPrefix(mode, "0")}},
+	})
+	return err
+}
+
+// sameFileMode reports whether two octal modes are equal, so that "0755" in
+// the configuration matches "755" from the panel.
+func sameFileMode(a, b string) bool {
+	x, errA := strconv.ParseUint(a, 8, 32)
+	y, errB := strconv.ParseUint(b, 8, 32)
+	return errA == nil && errB == nil && x == y
+}
+
+// copyServerFile duplicates file and returns the path of the copy. The copy
+// endpoint does not report the name it chose, so it is found by comparing
+// the directory listing before and after; "" means it could not be told.
+func copyServerFile(client *Client, serverID, file string) (string, error) {
+	dir := path.Dir(file)
+	before, err := listServerDirectory(client, serverID, dir)
+	if err != nil {
+		return "", err
+	}
+	if _, err := client.Post(filesPath(serverID, "copy", nil), map[string]string{"location": file}); err != nil {
+		return "", err
+	}
+	after, err := listServerDirectory(client, serverID, dir)
+	if err != nil {
+		return "", nil // the copy exists; only its name is unknown
+	}
+
+	existing := make(map[string]bool, len(before))
+	for _, o := range before {
+		existing[o.Name] = true
+	}
+	for _, o := range after {
+		if !existing[o.Name] {
+			return path.Join(dir, o.Name), nil
+		}
+	}
+	return "", nil
+}
+
+// decompressServerFile extracts an archive in place, next to the archive.
+func decompressServerFile(client *Client, serverID, directory, file string) error {
+	_, err := client.Post(filesPath(serverID, "decompress", nil), map[string]string{
+		"root": normalizeServerPath(directory),
+		"file": file,
+	})
+	return err
+}
+
+// deleteServerFiles deletes files or directories inside one directory.
+func deleteServerFiles(client *Client, serverID, directory string, files ...string) error {
+	_, err := client.Post(filesPath(serverID, "delete", nil), map[string]any{
+		"root":  normalizeServerPath(directory),
+		"files": files,
+	})
+	return err
+}
+
+// transferHTTPClient talks to the node for uploads and downloads. It has no
+// overall timeout: large archives can take a long time to transfer.
+// Cancellation comes from the request context instead.
+var transferHTTPClient = &http.Client{}
+
+// signedFileURL asks the panel for a short-lived node URL for the files
+// endpoint action ("upload" or "download").
+func signedFileURL(client *Client, serverID, action string, query url.Values) (*url.URL, error) {
+	body, err := client.Get(filesPath(serverID, action, query))
+	if err != nil {
+		return nil, fmt.Errorf("get %s URL: %w", action, err)
+	}
+	var signed struct {
+		Attributes struct {
+			URL string `json:"url"`
+		} `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &signed); err != nil {
+		return nil, err
+	}
+	target, err := url.Parse(signed.Attributes.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s URL: %w", action, err)
+	}
+	return target, nil
+}
+
+// downloadServerFile streams a file of the server volume into localPath
+// through a signed download URL on the node, and returns its size and SHA-256.
+func downloadServerFile(ctx context.Context, client *Client, serverID, file, localPath string) (int64, string, error) {
+	target, err := signedFileURL(client, serverID, "download", url.Values{"file": {normalizeServerPath(file)}})
+	if err != nil {
+		return 0, "", err
+	}
+	return downloadToFile(ctx, target.String(), localPath)
+}
+
+// downloadToFile streams a signed node URL into localPath and returns its
+// size and SHA-256. The data goes to a temporary file next to localPath that
+// replaces it only once complete, so it is never held in memory and an
+// interrupted download leaves no partial file behind.
+func downloadToFile(ctx context.Context, target, localPath string) (int64, string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", target, nil)
+	if err != nil {
+		return 0, "", err
+	}
+	resp, err := transferHTTPClient.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return 0, "", fmt.Errorf("node returned %d: %s", resp.StatusCode, bodySnippet(respBody))
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(localPath), "."+filepath.Base(localPath)+".*")
+	if err != nil {
+		return 0, "", err
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), resp.Body)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return 0, "", fmt.Errorf("download to %s: %w", localPath, err)
+	}
+	if err := os.Rename(tmp.Name(), localPath); err != nil {
+		return 0, "", err
+	}
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// uploadServerFile streams a local file into a directory of the server volume
+// through a signed upload URL on the node. The body is sent with chunked
+// transfer encoding, so the file is never held in memory. Transient failures
+// (classify calls them retryable) are retried with a fresh URL, since each URL
+// is only valid for a short time; anything else is returned right away.
+func uploadServerFile(ctx context.Context, client *Client, serverID, directory, localPath string, retries int) error {
+	var lastErr error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			tflog.Warn(ctx, "Retrying upload", map[string]any{"file": localPath, "attempt": attempt, "error": lastErr.Error()})
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt) * 5 * time.Second):
+			}
+		}
+		lastErr = uploadOnce(ctx, client, serverID, directory, localPath)
+		if lastErr == nil {
+			return nil
+		}
+		if classify(lastErr) != errClassRetryable {
+			return lastErr
+		}
+	}
+	return fmt.Errorf("upload failed after %d attempts: %w", retries+1, lastErr)
+}
+
+func uploadOnce(ctx context.Context, client *Client, serverID, directory, localPath string) error {
+	target, err := signedFileURL(client, serverID, "upload", nil)
+	if err != nil {
+		return err
+	}
+	q := target.Query()
+	q.Set("directory", normalizeServerPath(directory))
+	target.RawQuery = q.Encode()
+
+	f, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		part, err := mw.CreateFormFile("files", filepath.Base(localPath))
+		if err == nil {
+			_, err = io.Copy(part, f)
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, "POST", target.String(), pr)
+	if err != nil {
+		pr.Close()
+		return err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	resp, err := transferHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+		return fmt.Errorf("node rejected the upload: %w", newAPIError(resp, respBody))
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &CronNextRunsFunction{}
+
+// CronNextRunsFunction lists the next execution times of a schedule.
+type CronNextRunsFunction struct{}
+
+func NewCronNextRunsFunction() function.Function {
+	return &CronNextRunsFunction{}
+}
+
+func (f *CronNextRunsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_next_runs"
+}
+
+func (f *CronNextRunsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Next execution times of cron fields",
+		Description: "Returns the next `count` times after `from` at which a schedule with these cron fields runs, as RFC 3339 timestamps. " +
+			"Times are computed in the UTC offset of `from`: pass `plantimestamp()` for UTC, or a timestamp with the panel's offset when the panel runs in another timezone. " +
+			"Fails with the offending field when the expression is invalid.",
+		Parameters: append(cronParameters(),
+			function.StringParameter{Name: "from", Description: "RFC 3339 timestamp to start after, e.g. `plantimestamp()`."},
+			function.Int64Parameter{Name: "count", Description: "Number of times to return (1-100)."},
+		),
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *CronNextRunsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var minute, hour, dom, month, dow, from string
+	var count int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &minute, &hour, &dom, &month, &dow, &from, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	schedule, err := parseCron(minute, hour, dom, month, dow)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Invalid cron expression: %v.", err))
+		return
+	}
+	t, err := time.Parse(time.RFC3339, from)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(5, fmt.Sprintf("Invalid timestamp %q: expected RFC 3339, e.g. 2024-05-01T03:00:00Z.", from))
+		return
+	}
+	if count < 1 || count > 100 {
+		resp.Error = function.NewArgumentFuncError(6, fmt.Sprintf("count must be between 1 and 100, got %d.", count))
+		return
+	}
+
+	runs := make([]string, 0, count)
+	for len(runs) < int(count) {
+		next, ok := schedule.next(t)
+		if !ok {
+			break
+		}
+		runs = append(runs, next.Format(time.RFC3339))
+		t = next
+	}
+	resp.Error = resp.Result.Set(ctx, runs)
+}
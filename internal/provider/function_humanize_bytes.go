@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &HumanizeBytesFunction{}
+
+// HumanizeBytesFunction renders a byte count for outputs.
+type HumanizeBytesFunction struct{}
+
+func NewHumanizeBytesFunction() function.Function {
+	return &HumanizeBytesFunction{}
+}
+
+func (f *HumanizeBytesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "humanize_bytes"
+}
+
+func (f *HumanizeBytesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render a byte count",
+		Description: "Renders a number of bytes in the largest binary unit with up to two decimals, e.g. `4 GiB` or `1.5 MiB`, for outputs of attributes such as `total_bytes`. " +
+			"Limits the panel reports in MiB need converting first: `provider::kineticpanel::humanize_bytes(kineticpanel_server.mc.memory * 1048576)`.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "bytes",
+				Description: "Number of bytes.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *HumanizeBytesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bytes int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &bytes))
+	if resp.Error != nil {
+		return
+	}
+	if bytes < 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid byte count %d: must not be negative.", bytes))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, humanizeBytes(bytes))
+}
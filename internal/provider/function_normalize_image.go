@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &NormalizeImageFunction{}
+
+// NormalizeImageFunction returns the fully qualified form of an image reference.
+type NormalizeImageFunction struct{}
+
+func NewNormalizeImageFunction() function.Function {
+	return &NormalizeImageFunction{}
+}
+
+func (f *NormalizeImageFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_image"
+}
+
+func (f *NormalizeImageFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a Docker image reference",
+		Description: "Returns an image reference the way Docker resolves it, so images from eggs and from configuration compare equal: " +
+			"`java` becomes `docker.io/library/java:latest`, `user/app` becomes `docker.io/user/app:latest` and `index.docker.io` becomes `docker.io`. " +
+			"Other registries and explicit tags are kept; references with a digest get no default tag.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "image",
+				Description: "Image reference, e.g. `ghcr.io/pterodactyl/yolks:java_17`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeImageFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var image string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &image))
+	if resp.Error != nil {
+		return
+	}
+	normalized, err := normalizeImage(image)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid image reference: "+err.Error()+".")
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseAddressFunction{}
+
+// ParseAddressFunction splits a `host:port` address, so the `address`
+// outputs can feed srv_record and DNS records directly.
+type ParseAddressFunction struct{}
+
+var parseAddressAttrTypes = map[string]attr.Type{
+	"host": types.StringType,
+	"port": types.Int64Type,
+}
+
+func NewParseAddressFunction() function.Function {
+	return &ParseAddressFunction{}
+}
+
+func (f *ParseAddressFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_address"
+}
+
+func (f *ParseAddressFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split a host:port address",
+		Description: "Splits an address such as the `address` attribute of `kineticpanel_server` into `host` and `port`. IPv6 hosts are written in brackets (`[2001:db8::1]:25565`) and returned without them.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "address",
+				Description: "Address in `host:port` form.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: parseAddressAttrTypes},
+	}
+}
+
+func (f *ParseAddressFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var address string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &address))
+	if resp.Error != nil {
+		return
+	}
+
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid address %q: %v.", address, err))
+		return
+	}
+	port, err := strconv.ParseInt(portStr, 10, 64)
+	if err != nil || port < 1 || port > 65535 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid port %q in address %q.", portStr, address))
+		return
+	}
+
+	result, diags := types.ObjectValue(parseAddressAttrTypes, map[string]attr.Value{
+		"host": types.StringValue(host),
+		"port": types.Int64Value(port),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ParseSizeFunction{}
+
+// ParseSizeFunction converts a human-readable size to the MiB the panel's
+// limits take.
+type ParseSizeFunction struct{}
+
+func NewParseSizeFunction() function.Function {
+	return &ParseSizeFunction{}
+}
+
+func (f *ParseSizeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_size"
+}
+
+func (f *ParseSizeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a size to MiB",
+		Description: "Converts a size such as `4GiB` to MiB, the unit of the `memory`, `swap` and `disk` limits: `memory = provider::kineticpanel::parse_size(\"4GiB\")` sets 4096. " +
+			"IEC units (`KiB`, `MiB`, `GiB`, `TiB`, `PiB`) and the single letters `K`, `M`, `G`, `T`, `P` are binary, SI units (`kB`, `MB`, `GB`, `TB`, `PB`) decimal; units are case-insensitive. " +
+			"A number without unit is taken as MiB. Fractions are allowed and the result is rounded down to whole MiB.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "size",
+				Description: "Size, e.g. `4GiB`, `1.5 G` or `512MiB`.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *ParseSizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var size string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &size))
+	if resp.Error != nil {
+		return
+	}
+	result, err := parseSizeMiB(size)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error()+".")
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
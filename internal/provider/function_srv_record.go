@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &SrvRecordFunction{}
+
+// SrvRecordFunction builds the DNS records that point a domain at a
+// Minecraft server on a non-default port.
+type SrvRecordFunction struct{}
+
+var srvRecordAttrTypes = map[string]attr.Type{
+	"name":         types.StringType,
+	"service":      types.StringType,
+	"proto":        types.StringType,
+	"priority":     types.Int64Type,
+	"weight":       types.Int64Type,
+	"port":         types.Int64Type,
+	"target":       types.StringType,
+	"value":        types.StringType,
+	"address_type": types.StringType,
+	"address":      types.StringType,
+}
+
+func NewSrvRecordFunction() function.Function {
+	return &SrvRecordFunction{}
+}
+
+func (f *SrvRecordFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "srv_record"
+}
+
+func (f *SrvRecordFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Values for a `_minecraft._tcp` SRV record",
+		Description: "Returns the fields DNS providers need for a `_minecraft._tcp.<domain>` SRV record pointing at `port` on `domain`, together with the A/AAAA record that resolves `domain` to `ip`. " +
+			"`name`, `target` and `address` are fully qualified without a trailing dot; `value` is the record data in zone-file form (`0 5 <port> <domain>.`), as Route 53 expects it. " +
+			"Cloudflare takes `service`, `proto`, `priority`, `weight`, `port` and `target` separately.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ip",
+				Description: "IPv4 or IPv6 address of the allocation, e.g. `allocation_ip` of the `kineticpanel_server` data source.",
+			},
+			function.Int64Parameter{
+				Name:        "port",
+				Description: "Port of the allocation.",
+			},
+			function.StringParameter{
+				Name:        "domain",
+				Description: "Domain players connect to, e.g. `play.example.com`.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: srvRecordAttrTypes},
+	}
+}
+
+func (f *SrvRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ip, domain string
+	var port int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ip, &port, &domain))
+	if resp.Error != nil {
+		return
+	}
+
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not an IP address.", ip))
+		return
+	}
+	addressType := "AAAA"
+	if addr.To4() != nil {
+		addressType = "A"
+	}
+	if port < minPort || port > maxPort {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Port %d is outside 1-65535.", port))
+		return
+	}
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if domain == "" {
+		resp.Error = function.NewArgumentFuncError(2, "The domain must not be empty.")
+		return
+	}
+
+	const priority, weight = 0, 5
+	record, diags := types.ObjectValue(srvRecordAttrTypes, map[string]attr.Value{
+		"name":         types.StringValue("_minecraft._tcp." + domain),
+		"service":      types.StringValue("_minecraft"),
+		"proto":        types.StringValue("_tcp"),
+		"priority":     types.Int64Value(priority),
+		"weight":       types.Int64Value(weight),
+		"port":         types.Int64Value(port),
+		"target":       types.StringValue(domain),
+		"value":        types.StringValue(fmt.Sprintf("%d %d %d %s.", priority, weight, port, domain)),
+		"address_type": types.StringValue(addressType),
+		"address":      types.StringValue(addr.String()),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, record)
+}
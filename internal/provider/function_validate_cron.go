@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ValidateCronFunction{}
+
+// ValidateCronFunction checks a schedule's cron fields at plan time.
+type ValidateCronFunction struct{}
+
+func NewValidateCronFunction() function.Function {
+	return &ValidateCronFunction{}
+}
+
+// cronParameters are the five cron fields, shared by the cron functions.
+func cronParameters() []function.Parameter {
+	return []function.Parameter{
+		function.StringParameter{Name: "minute", Description: "Minute field, e.g. `*/15`."},
+		function.StringParameter{Name: "hour", Description: "Hour field, e.g. `3`."},
+		function.StringParameter{Name: "day_of_month", Description: "Day of month field, e.g. `*`."},
+		function.StringParameter{Name: "month", Description: "Month field, e.g. `*` or `jan-jun`."},
+		function.StringParameter{Name: "day_of_week", Description: "Day of week field, e.g. `mon-fri`; 0 and 7 are Sunday."},
+	}
+}
+
+func (f *ValidateCronFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_cron"
+}
+
+func (f *ValidateCronFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check cron fields",
+		Description: "Returns whether the five fields form a cron expression the panel accepts, for `validation` blocks of schedule modules. " +
+			"Supports `*`, values, ranges, steps, lists and month and weekday names.",
+		Parameters: cronParameters(),
+		Return:     function.BoolReturn{},
+	}
+}
+
+func (f *ValidateCronFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var minute, hour, dom, month, dow string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &minute, &hour, &dom, &month, &dow))
+	if resp.Error != nil {
+		return
+	}
+	_, err := parseCron(minute, hour, dom, month, dow)
+	resp.Error = resp.Result.Set(ctx, err == nil)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// normalizeHost turns user input such as `panel.example.com`,
+// `https://panel.example.com/` or `https://panel.example.com/api/client`
+// into the panel URL NewClient expects: scheme and host, plus any path the
+// panel is served under, without a trailing slash or API suffix.
+func normalizeHost(raw string) (string, error) {
+	host := strings.TrimSpace(raw)
+	if host == "" {
+		return "", fmt.Errorf("host is empty")
+	}
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid URL: %v", raw, err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return "", fmt.Errorf("%q must use http or https, not %s", raw, u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("%q has no host name", raw)
+	}
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", fmt.Errorf("%q must not contain credentials, a query or a fragment", raw)
+	}
+
+	pth := strings.TrimRight(u.Path, "/")
+	for _, suffix := range []string{"/api/application", "/api/client", "/api"} {
+		if strings.HasSuffix(pth, suffix) {
+			pth = strings.TrimSuffix(pth, suffix)
+			break
+		}
+	}
+	return u.Scheme + "://" + u.Host + pth, nil
+}
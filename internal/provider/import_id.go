@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Import IDs of Client API resources are the server identifier, followed by
+// `:`-separated keys for resources nested below a server, e.g.
+// `<server_id>:<backup_uuid>`. The last part takes the rest of the ID, so
+// file paths may contain `:`.
+
+// importIDPart is one `:`-separated part of an import ID.
+type importIDPart struct {
+	Attr  string                    // attribute set from the part
+	Label string                    // shown in errors, e.g. `<backup_uuid>`
+	Parse func(string) (any, error) // converts and validates; nil keeps the string
+}
+
+var (
+	serverIdentifierPattern = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z-]*$`)
+	uuidPattern             = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// serverIDPart is the leading server identifier of every Client API import ID.
+var serverIDPart = importIDPart{
+	Attr:  "server_id",
+	Label: "<server_id>",
+	Parse: func(s string) (any, error) {
+		if !serverIdentifierPattern.MatchString(s) {
+			return nil, fmt.Errorf("%q is not a server identifier; use the short identifier shown in the panel URL (e.g. `1a2b3c4d`) or the full UUID", s)
+		}
+		return s, nil
+	},
+}
+
+// uuidPart is a part holding a UUID, such as a backup.
+func uuidPart(attr, label string) importIDPart {
+	return importIDPart{Attr: attr, Label: label, Parse: func(s string) (any, error) {
+		if !uuidPattern.MatchString(s) {
+			return nil, fmt.Errorf("%q is not a UUID", s)
+		}
+		return strings.ToLower(s), nil
+	}}
+}
+
+// int64Part is a part holding a numeric ID, such as a schedule.
+func int64Part(attr, label string) importIDPart {
+	return importIDPart{Attr: attr, Label: label, Parse: func(s string) (any, error) {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("%q is not a numeric ID", s)
+		}
+		return n, nil
+	}}
+}
+
+// importComposite splits req.ID into parts and sets their attributes.
+func importComposite(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, parts ...importIDPart) {
+	labels := make([]string, len(parts))
+	for i, p := range parts {
+		labels[i] = p.Label
+	}
+	format := strings.Join(labels, ":")
+
+	values := strings.SplitN(req.ID, ":", len(parts))
+	if len(values) != len(parts) {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected format: %s, got %q.", format, req.ID))
+		return
+	}
+	for i, p := range parts {
+		if values[i] == "" {
+			resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected format: %s, got %q with an empty %s.", format, req.ID, p.Label))
+			return
+		}
+		var value any = values[i]
+		if p.Parse != nil {
+			v, err := p.Parse(values[i])
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected format: %s. %s: %v.", format, p.Label, err))
+				return
+			}
+			value = v
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(p.Attr), value)...)
+	}
+}
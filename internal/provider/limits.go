@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Resource limits accept 0 for unlimited; anything else below what a server
+// can run with is rejected at plan time instead of failing on the node.
+
+const (
+	minMemoryMiB  = 128 // below this the container cannot start most images
+	minDiskMiB    = 256 // room for the egg's install script
+	minCPUPercent = 10  // a tenth of a core
+)
+
+var _ validator.Int64 = limitValidator{}
+
+// limitValidator checks a limit that is either 0 (unlimited) or at least min.
+type limitValidator struct {
+	name string
+	min  int64
+	unit string
+}
+
+// minLimit returns a validator for a limit that is 0 for unlimited or at least min unit.
+func minLimit(name string, min int64, unit string) validator.Int64 {
+	return limitValidator{name: name, min: min, unit: unit}
+}
+
+func memoryLimitValidator() validator.Int64 { return minLimit("memory", minMemoryMiB, "MiB") }
+func diskLimitValidator() validator.Int64   { return minLimit("disk", minDiskMiB, "MiB") }
+func cpuLimitValidator() validator.Int64    { return minLimit("cpu", minCPUPercent, "percent") }
+
+func (v limitValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("%s must be 0 for unlimited or at least %d %s", v.name, v.min, v.unit)
+}
+
+func (v limitValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v limitValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueInt64()
+	if value == 0 || value >= v.min {
+		return
+	}
+	detail := fmt.Sprintf("%s is %d, but %s.", v.name, value, v.Description(ctx))
+	if value < 0 {
+		detail += " Negative values are not accepted; use 0 for unlimited."
+	}
+	resp.Diagnostics.AddAttributeError(req.Path, "Invalid Resource Limit", detail)
+}
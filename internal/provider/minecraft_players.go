@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Helpers for the JSON player lists of a Minecraft server (`whitelist.json`,
+// `ops.json`). Entries not managed by Terraform are kept as read, including
+// fields this provider does not know about.
+
+const mojangProfileURL = "https://api.mojang.com/users/profiles/minecraft/"
+
+// mojangHTTPClient returns a client for the Mojang API that shares the
+// provider's transport, so with `mock_responses_dir` set lookups are answered
+// from <dir>/GET/users/profiles/minecraft/<name>.json.
+func mojangHTTPClient(client *Client) *http.Client {
+	return &http.Client{Timeout: 10 * time.Second, Transport: client.httpClient.Transport}
+}
+
+// playerEntry is one object of a player list file.
+type playerEntry map[string]any
+
+func (e playerEntry) name() string {
+	n, _ := e["name"].(string)
+	return n
+}
+
+func (e playerEntry) uuid() string {
+	u, _ := e["uuid"].(string)
+	return u
+}
+
+// findPlayer returns the entry for name; player names are case-insensitive.
+func findPlayer(entries []playerEntry, name string) (playerEntry, bool) {
+	for _, e := range entries {
+		if strings.EqualFold(e.name(), name) {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// loadPlayerList reads a player list file; a missing or empty file reads as empty.
+func loadPlayerList(client *Client, serverID, file string) ([]playerEntry, error) {
+	body, err := readServerFile(client, serverID, file)
+	if err != nil {
+		if isNotFound(err) {
+			return []playerEntry{}, nil
+		}
+		return nil, err
+	}
+	entries := []playerEntry{}
+	if strings.TrimSpace(string(body)) == "" {
+		return entries, nil
+	}
+	if err := json.Unmarshal(body, &entries); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", file, err)
+	}
+	return entries, nil
+}
+
+// savePlayerList writes a player list file in the format the server uses.
+func savePlayerList(client *Client, serverID, file string, entries []playerEntry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeServerFile(client, serverID, file, append(data, '\n'))
+}
+
+// mergePlayers drops the entries named in remove, then replaces or appends
+// upsert entries. Existing entries keep their position in the file.
+func mergePlayers(entries []playerEntry, remove []string, upsert []playerEntry) []playerEntry {
+	merged := make([]playerEntry, 0, len(entries)+len(upsert))
+	for _, e := range entries {
+		dropped := false
+		for _, n := range remove {
+			if strings.EqualFold(e.name(), n) {
+				dropped = true
+				break
+			}
+		}
+		if !dropped {
+			merged = append(merged, e)
+		}
+	}
+
+	for _, u := range upsert {
+		replaced := false
+		for i, e := range merged {
+			if strings.EqualFold(e.name(), u.name()) {
+				for k, v := range u {
+					e[k] = v
+				}
+				merged[i] = e
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, u)
+		}
+	}
+	return merged
+}
+
+// removedPlayers returns the names in previous that are no longer in current.
+func removedPlayers(previous, current []string) []string {
+	var removed []string
+	for _, p := range previous {
+		kept := false
+		for _, c := range current {
+			if strings.EqualFold(p, c) {
+				kept = true
+				break
+			}
+		}
+		if !kept {
+			removed = append(removed, p)
+		}
+	}
+	return removed
+}
+
+// observedPlayers reports which managed names are present in entries, keeping
+// the configured spelling, along with their UUIDs. Missing names show up as drift.
+func observedPlayers(entries []playerEntry, managed []string) (types.Set, types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var names []string
+	uuids := map[string]string{}
+	for _, name := range managed {
+		if e, ok := findPlayer(entries, name); ok {
+			names = append(names, name)
+			uuids[name] = e.uuid()
+		}
+	}
+	set, d := stringSetValue(names)
+	diags.Append(d...)
+	m, d := stringMapValue(uuids)
+	diags.Append(d...)
+	return set, m, diags
+}
+
+// resolvePlayerUUID returns the UUID for a player name. Names already in
+// entries reuse their UUID; otherwise it is looked up at Mojang, or derived
+// the way an offline-mode server does. Pass no entries to resolve every name
+// anew, e.g. after offline_mode changed.
+func resolvePlayerUUID(client *Client, entries []playerEntry, name string, offline bool) (string, error) {
+	if e, ok := findPlayer(entries, name); ok && e.uuid() != "" {
+		return e.uuid(), nil
+	}
+	if offline {
+		return offlinePlayerUUID(name), nil
+	}
+
+	req, err := http.NewRequestWithContext(client.context(), http.MethodGet, mojangProfileURL+url.PathEscape(name), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := mojangHTTPClient(client).Do(req)
+	if err != nil {
+		return "", fmt.Errorf("look up player %q: %w", name, err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	// Unknown names return 204 (older API) or 404.
+	if resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("player %q does not exist", name)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("look up player %q: Mojang API error %d: %s", name, resp.StatusCode, string(body))
+	}
+
+	var profile struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &profile); err != nil {
+		return "", fmt.Errorf("look up player %q: %w", name, err)
+	}
+	return dashedUUID(profile.ID), nil
+}
+
+// offlinePlayerUUID mirrors UUID.nameUUIDFromBytes("OfflinePlayer:" + name).
+func offlinePlayerUUID(name string) string {
+	h := md5.Sum([]byte("OfflinePlayer:" + name))
+	h[6] = h[6]&0x0f | 0x30 // version 3
+	h[8] = h[8]&0x3f | 0x80 // IETF variant
+	return dashedUUID(hex.EncodeToString(h[:]))
+}
+
+// dashedUUID formats a 32 character hex UUID as 8-4-4-4-12.
+func dashedUUID(id string) string {
+	if len(id) != 32 {
+		return id
+	}
+	return id[0:8] + "-" + id[8:12] + "-" + id[12:16] + "-" + id[16:20] + "-" + id[20:32]
+}
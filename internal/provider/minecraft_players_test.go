@@ -0,0 +1,33 @@
+package provider
+
+import "testing"
+
+func TestResolvePlayerUUID(t *testing.T) {
+	client := mockClient(t, map[string]string{
+		"GET/users/profiles/minecraft/Notch.json": `{"id": "069a79f444e94726a5befca90e38aaf5", "name": "Notch"}`,
+	})
+
+	known := []playerEntry{{"name": "notch", "uuid": "00000000-0000-0000-0000-000000000001"}}
+	tests := []struct {
+		name    string
+		entries []playerEntry
+		offline bool
+		want    string
+		wantErr bool
+	}{
+		{name: "Notch", want: "069a79f4-44e9-4726-a5be-fca90e38aaf5"},
+		{name: "Notch", entries: known, want: "00000000-0000-0000-0000-000000000001"},
+		{name: "Notch", offline: true, want: "b50ad385-829d-3141-a216-7e7d7539ba7f"},
+		{name: "Unknown", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := resolvePlayerUUID(client, tt.entries, tt.name, tt.offline)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("resolvePlayerUUID(%q, offline %v) error = %v, want error %v", tt.name, tt.offline, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("resolvePlayerUUID(%q, offline %v) = %q, want %q", tt.name, tt.offline, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// With `mock_responses_dir` set, the provider answers every API request
+// from files instead of the panel, so `terraform test` can run modules
+// without network access or credentials. The response to
+//
+//	GET https://panel.example.com/api/client/servers/1a2b3c4d
+//
+// is read from <dir>/GET/api/client/servers/1a2b3c4d.json and returned with
+// status 200. To answer with another status, name the file with it, e.g.
+// 1a2b3c4d.404.json. The query string is ignored, so list endpoints return
+// the same file for every page and filter. A request without a file fails
+// with 404 for GET, like a missing object on the panel, and succeeds with
+// 204 otherwise, which is what the panel answers to most changes.
+
+// mockTransport answers requests from the files below dir.
+type mockTransport struct {
+	dir string
+}
+
+// mockStatusGlob matches the status in a file name like `1a2b3c4d.404.json`.
+const mockStatusGlob = ".[1-5][0-9][0-9].json"
+
+func (t mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	clean := path.Clean("/" + req.URL.Path)
+	base := filepath.Join(t.dir, req.Method, filepath.FromSlash(strings.TrimPrefix(clean, "/")))
+
+	status, file := http.StatusOK, base+".json"
+	if _, err := os.Stat(file); err != nil {
+		matches, _ := filepath.Glob(base + mockStatusGlob)
+		if len(matches) == 0 {
+			return t.missing(req, base), nil
+		}
+		file = matches[0]
+		status, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(file, base+"."), ".json"))
+	}
+	body, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read mock response: %w", err)
+	}
+	return mockResponse(req, status, body), nil
+}
+
+// missing answers a request without a mock file.
+func (t mockTransport) missing(req *http.Request, base string) *http.Response {
+	if req.Method != http.MethodGet {
+		return mockResponse(req, http.StatusNoContent, nil)
+	}
+	body, _ := json.Marshal(map[string]any{
+		"errors": []map[string]string{{
+			"code":   "NotFoundHttpException",
+			"status": "404",
+			"detail": fmt.Sprintf("No mock response for %s %s; create %s.json", req.Method, req.URL.Path, base),
+		}},
+	})
+	return mockResponse(req, http.StatusNotFound, body)
+}
+
+// mockResponse builds a response with body, typed as JSON when it is JSON
+// and as text otherwise (e.g. file contents).
+func mockResponse(req *http.Request, status int, body []byte) *http.Response {
+	header := http.Header{}
+	if len(body) > 0 {
+		if json.Valid(body) {
+			header.Set("Content-Type", "application/json")
+		} else {
+			header.Set("Content-Type", "text/plain; charset=utf-8")
+		}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(string(body))),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Helpers around the Application API node endpoints.
+
+// nodeAttributes is a node as returned by the Application API (with `include=servers`).
+type nodeAttributes struct {
+	ID                 int64  `json:"id"`
+	UUID               string `json:"uuid"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	LocationID         int64  `json:"location_id"`
+	Public             bool   `json:"public"`
+	FQDN               string `json:"fqdn"`
+	Scheme             string `json:"scheme"`
+	BehindProxy        bool   `json:"behind_proxy"`
+	Memory             int64  `json:"memory"`
+	MemoryOverallocate int64  `json:"memory_overallocate"`
+	Disk               int64  `json:"disk"`
+	DiskOverallocate   int64  `json:"disk_overallocate"`
+	UploadSize         int64  `json:"upload_size"`
+	DaemonListen       int64  `json:"daemon_listen"`
+	DaemonSFTP         int64  `json:"daemon_sftp"`
+	DaemonBase         string `json:"daemon_base"`
+	MaintenanceMode    bool   `json:"maintenance_mode"`
+	Relationships      struct {
+		Servers struct {
+			Data []struct {
+				Attributes struct {
+					ID     int64 `json:"id"`
+					Limits struct {
+						Memory int64 `json:"memory"`
+						Disk   int64 `json:"disk"`
+					} `json:"limits"`
+				} `json:"attributes"`
+			} `json:"data"`
+		} `json:"servers"`
+	} `json:"relationships"`
+}
+
+// getNode fetches a node together with its servers.
+func getNode(client *Client, nodeID int64) (nodeAttributes, error) {
+	body, err := client.Get(fmt.Sprintf("/nodes/%d?include=servers", nodeID))
+	if err != nil {
+		return nodeAttributes{}, err
+	}
+	var apiResp struct {
+		Attributes nodeAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nodeAttributes{}, err
+	}
+	return apiResp.Attributes, nil
+}
+
+// allocated sums the memory and disk limits of the node's servers.
+func (n nodeAttributes) allocated() (memory, disk int64) {
+	for _, s := range n.Relationships.Servers.Data {
+		memory += s.Attributes.Limits.Memory
+		disk += s.Attributes.Limits.Disk
+	}
+	return memory, disk
+}
+
+// withOverallocation applies an overallocation percentage to a total. The
+// panel treats negative percentages as "no limit check", reported here as
+// the plain total.
+func withOverallocation(total, percent int64) int64 {
+	if percent <= 0 {
+		return total
+	}
+	return total + total*percent/100
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Helpers to find out what panel the provider talks to and what the key may do.
+// The panel does not publish its version or the key's permissions, so both are
+// inferred from which endpoints answer.
+
+// panelScopeProbes maps a readable scope to a cheap endpoint that needs it.
+var panelScopeProbes = map[string]map[string]string{
+	"application": {
+		"servers":   "/servers?per_page=1",
+		"nodes":     "/nodes?per_page=1",
+		"locations": "/locations?per_page=1",
+		"nests":     "/nests?per_page=1",
+		"users":     "/users?per_page=1",
+	},
+	"client": {
+		"account": "/account",
+		"servers": "?per_page=1",
+	},
+}
+
+// panelInfo is what could be detected about the panel.
+type panelInfo struct {
+	Host     string
+	Scope    string // "application" or "client"
+	Flavor   string // "pterodactyl", "pelican" or "unknown"
+	Version  string // best-effort minimum version, empty when unknown
+	Readable []string
+}
+
+// apiScope returns the API the client is bound to and the panel host.
+func (c *Client) apiScope() (scope, host string) {
+	if h, ok := strings.CutSuffix(c.BaseURL, "/api/application"); ok {
+		return scopeApplication, h
+	}
+	return scopeClient, strings.TrimSuffix(c.BaseURL, "/api/client")
+}
+
+// clientAPI returns a Client API client for the same panel, for resources on
+// the Application API that need a Client API key for power, backup or file
+// calls. It shares the transport, so mock responses apply to it as well, and
+// the provider's wait_for_ready setting and the operation's context.
+func (c *Client) clientAPI(apiKey string) *Client {
+	_, host := c.apiScope()
+	cc := NewClient(host, apiKey, false)
+	cc.httpClient.Transport = c.httpClient.Transport
+	cc.WaitForReady = c.WaitForReady
+	cc.ctx = c.ctx
+	return cc
+}
+
+// probe reports whether GET path succeeds. Only authorization and missing
+// endpoint errors count as "no"; anything else is returned.
+func (c *Client) probe(path string) (bool, error) {
+	_, err := c.Get(path)
+	if err == nil {
+		return true, nil
+	}
+	if hasStatus(err, http.StatusForbidden, http.StatusNotFound, http.StatusMethodNotAllowed) {
+		return false, nil
+	}
+	return false, err
+}
+
+// detectPanel probes the panel. An invalid key fails the first probe with a 401.
+func detectPanel(client *Client) (panelInfo, error) {
+	scope, host := client.apiScope()
+	info := panelInfo{Host: host, Scope: scope, Flavor: "unknown", Readable: []string{}}
+
+	for name, pth := range panelScopeProbes[scope] {
+		ok, err := client.probe(pth)
+		if err != nil {
+			return panelInfo{}, err
+		}
+		if ok {
+			info.Readable = append(info.Readable, name)
+		}
+	}
+	sort.Strings(info.Readable)
+
+	switch scope {
+	case "application":
+		// Roles only exist on Pelican.
+		ok, err := client.probe("/roles?per_page=1")
+		if err != nil {
+			return panelInfo{}, err
+		}
+		if ok {
+			info.Flavor = "pelican"
+		} else {
+			info.Flavor = "pterodactyl"
+		}
+	case "client":
+		// Account activity logs were added in Pterodactyl 1.8.
+		ok, err := client.probe("/account/activity?per_page=1")
+		if err != nil {
+			return panelInfo{}, err
+		}
+		if ok {
+			info.Version = ">= 1.8"
+		}
+	}
+	return info, nil
+}
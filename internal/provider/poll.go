@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// pollInterval is the default time between two checks of a long-running
+// operation (install, backup, power change).
+const pollInterval = 10 * time.Second
+
+// pollOptions configures poll.
+type pollOptions struct {
+	What     string         // what is awaited, for the log, e.g. "server install"
+	Fields   map[string]any // extra log fields, e.g. the server ID
+	Interval time.Duration  // time between checks; pollInterval when zero
+	Timeout  time.Duration  // give up after this long; only ctx limits the wait when zero
+
+	// TimeoutError builds the error returned on timeout from the last status
+	// check reported. Without it a generic message is used.
+	TimeoutError func(status string) error
+}
+
+// poll runs check until it reports done or fails, the timeout passes, or ctx
+// is cancelled. check returns a short description of the current status for
+// the log and the timeout error. The first check runs immediately.
+func poll(ctx context.Context, opts pollOptions, check func() (done bool, status string, err error)) error {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = pollInterval
+	}
+	var deadline time.Time
+	if opts.Timeout > 0 {
+		deadline = time.Now().Add(opts.Timeout)
+	}
+
+	for {
+		done, status, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			if opts.TimeoutError != nil {
+				return opts.TimeoutError(status)
+			}
+			return fmt.Errorf("%s did not finish within %s; last status was %q", opts.What, opts.Timeout, status)
+		}
+		fields := map[string]any{"status": status}
+		for k, v := range opts.Fields {
+			fields[k] = v
+		}
+		tflog.Debug(ctx, "Waiting for "+opts.What, fields)
+
+		wait := interval
+		if !deadline.IsZero() {
+			// Check once more right at the deadline rather than sleeping past it.
+			wait = min(wait, max(time.Until(deadline), time.Second))
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
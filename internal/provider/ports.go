@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Validators for port inputs, so mistakes show up at plan time with the
+// offending value instead of as a panel error during apply.
+
+const (
+	minPort           = 1
+	maxPort           = 65535
+	minAllocationPort = 1024 // the panel refuses privileged ports
+	maxPortRangeSize  = 1000 // the panel refuses larger ranges
+)
+
+// parsePortRange parses `25565` or `25565-25570` and checks that it lies
+// within min-65535 and holds at most maxPortRangeSize ports.
+func parsePortRange(s string, min int64) (lo, hi int64, err error) {
+	s = strings.TrimSpace(s)
+	start, end, isRange := strings.Cut(s, "-")
+	lo, err = strconv.ParseInt(strings.TrimSpace(start), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port %q: expected a port such as 25565 or a range such as 25565-25570", s)
+	}
+	hi = lo
+	if isRange {
+		if hi, err = strconv.ParseInt(strings.TrimSpace(end), 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid port range %q: expected a range such as 25565-25570", s)
+		}
+		if hi < lo {
+			return 0, 0, fmt.Errorf("port range %q starts above its end", s)
+		}
+	}
+	if lo < min || hi > maxPort {
+		return 0, 0, fmt.Errorf("port range %q must be within %d-%d", s, min, maxPort)
+	}
+	if hi-lo >= maxPortRangeSize {
+		return 0, 0, fmt.Errorf("port range %q has more than %d ports", s, maxPortRangeSize)
+	}
+	return lo, hi, nil
+}
+
+var _ validator.Int64 = portValidator{}
+
+// portValidator checks that a port is within 1-65535.
+type portValidator struct{}
+
+func (v portValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("must be a port within %d-%d", minPort, maxPort)
+}
+
+func (v portValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if port := req.ConfigValue.ValueInt64(); port < minPort || port > maxPort {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Port", fmt.Sprintf("Port %d %s.", port, v.Description(ctx)))
+	}
+}
+
+var _ validator.String = portRangeValidator{}
+
+// portRangeValidator checks a `port` or `start-end` string.
+type portRangeValidator struct {
+	min int64
+}
+
+func (v portRangeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("must be a port or a range of at most %d ports within %d-%d", maxPortRangeSize, v.min, maxPort)
+}
+
+func (v portRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portRangeValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, _, err := parsePortRange(req.ConfigValue.ValueString(), v.min); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Port Range", upperFirst(err.Error())+".")
+	}
+}
+
+var _ validator.List = portRangesOverlapValidator{}
+
+// portRangesOverlapValidator rejects a list of port ranges in which two
+// entries share a port. Invalid entries are left to portRangeValidator.
+type portRangesOverlapValidator struct{}
+
+func (v portRangesOverlapValidator) Description(_ context.Context) string {
+	return "port ranges must not overlap"
+}
+
+func (v portRangesOverlapValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portRangesOverlapValidator) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	type entry struct {
+		index  int
+		value  string
+		lo, hi int64
+	}
+	var entries []entry
+	for i, elem := range req.ConfigValue.Elements() {
+		s, ok := elem.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			continue
+		}
+		lo, hi, err := parsePortRange(s.ValueString(), minPort)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry{i, s.ValueString(), lo, hi})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].lo < entries[j].lo })
+	// widest is the entry reaching furthest so far, which a later entry
+	// overlaps if it overlaps any.
+	for i, widest := 1, 0; i < len(entries); i++ {
+		prev, cur := entries[widest], entries[i]
+		if cur.lo <= prev.hi {
+			resp.Diagnostics.AddAttributeError(req.Path.AtListIndex(cur.index), "Overlapping Port Ranges",
+				fmt.Sprintf("%q overlaps %q; list every port only once.", cur.value, prev.value))
+		}
+		if cur.hi > prev.hi {
+			widest = i
+		}
+	}
+}
+
+// upperFirst capitalizes the first letter of an error message for a diagnostic.
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
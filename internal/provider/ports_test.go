@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParsePortRange(t *testing.T) {
+	tests := []struct {
+		in      string
+		min     int64
+		lo, hi  int64
+		wantErr bool
+	}{
+		{in: "25565", min: minPort, lo: 25565, hi: 25565},
+		{in: "25565-25570", min: minPort, lo: 25565, hi: 25570},
+		{in: " 25565 - 25570 ", min: minPort, lo: 25565, hi: 25570},
+		{in: "1", min: minPort, lo: 1, hi: 1},
+		{in: "65535", min: minPort, lo: 65535, hi: 65535},
+		{in: "1024-2023", min: minAllocationPort, lo: 1024, hi: 2023},
+		{in: "1024-2024", min: minAllocationPort, wantErr: true},
+		{in: "80", min: minAllocationPort, wantErr: true},
+		{in: "0", min: minPort, wantErr: true},
+		{in: "65536", min: minPort, wantErr: true},
+		{in: "65530-65540", min: minPort, wantErr: true},
+		{in: "25570-25565", min: minPort, wantErr: true},
+		{in: "25565-", min: minPort, wantErr: true},
+		{in: "-25565", min: minPort, wantErr: true},
+		{in: "port", min: minPort, wantErr: true},
+		{in: "", min: minPort, wantErr: true},
+	}
+	for _, tt := range tests {
+		lo, hi, err := parsePortRange(tt.in, tt.min)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parsePortRange(%q, %d) error = %v, want error %v", tt.in, tt.min, err, tt.wantErr)
+			continue
+		}
+		if lo != tt.lo || hi != tt.hi {
+			t.Errorf("parsePortRange(%q, %d) = %d-%d, want %d-%d", tt.in, tt.min, lo, hi, tt.lo, tt.hi)
+		}
+	}
+}
+
+func TestPortValidator(t *testing.T) {
+	tests := []struct {
+		value   types.Int64
+		wantErr bool
+	}{
+		{types.Int64Value(25565), false},
+		{types.Int64Value(1), false},
+		{types.Int64Value(0), true},
+		{types.Int64Value(65536), true},
+		{types.Int64Null(), false},
+		{types.Int64Unknown(), false},
+	}
+	for _, tt := range tests {
+		var resp validator.Int64Response
+		portValidator{}.ValidateInt64(context.Background(), validator.Int64Request{Path: path.Root("port"), ConfigValue: tt.value}, &resp)
+		if resp.Diagnostics.HasError() != tt.wantErr {
+			t.Errorf("portValidator(%s) errors = %v, want error %v", tt.value, resp.Diagnostics, tt.wantErr)
+		}
+	}
+}
+
+func TestPortRangeValidator(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{types.StringValue("25565-25570"), false},
+		{types.StringValue("1000"), true},
+		{types.StringValue("25565-30000"), true},
+		{types.StringNull(), false},
+		{types.StringUnknown(), false},
+	}
+	for _, tt := range tests {
+		var resp validator.StringResponse
+		portRangeValidator{min: minAllocationPort}.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("port_range"), ConfigValue: tt.value}, &resp)
+		if resp.Diagnostics.HasError() != tt.wantErr {
+			t.Errorf("portRangeValidator(%s) errors = %v, want error %v", tt.value, resp.Diagnostics, tt.wantErr)
+		}
+	}
+}
+
+func TestPortRangesOverlapValidator(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []attr.Value
+		errors int
+	}{
+		{name: "disjoint", ranges: stringValues("25565", "25566-25570", "30000-30010")},
+		{name: "adjacent", ranges: stringValues("25565-25569", "25570")},
+		{name: "duplicate port", ranges: stringValues("25565", "25565"), errors: 1},
+		{name: "port in range", ranges: stringValues("25565-25570", "25567"), errors: 1},
+		{name: "unsorted", ranges: stringValues("30000", "25565-25570", "25570-25575"), errors: 1},
+		{name: "inside earlier wider range", ranges: stringValues("25000-25900", "25100-25200", "25500-25600"), errors: 2},
+		{name: "invalid entries skipped", ranges: stringValues("nope", "25565", "nope"), errors: 0},
+		{name: "unknown entry", ranges: []attr.Value{types.StringUnknown(), types.StringValue("25565")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp validator.ListResponse
+			req := validator.ListRequest{
+				Path:        path.Root("port_range"),
+				ConfigValue: types.ListValueMust(types.StringType, tt.ranges),
+			}
+			portRangesOverlapValidator{}.ValidateList(context.Background(), req, &resp)
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d: %v", got, tt.errors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func stringValues(values ...string) []attr.Value {
+	list := make([]attr.Value, len(values))
+	for i, v := range values {
+		list[i] = types.StringValue(v)
+	}
+	return list
+}
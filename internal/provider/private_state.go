@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Resources keep what they last wrote to or saw on the panel in the
+// framework's private state, which Terraform stores with the resource but
+// never shows. Update compares the plan with it to skip writes that would not
+// change anything, and Read to skip lookups whose answer is already known.
+
+// privateStateGetter and privateStateSetter are the methods of the
+// framework's private state, which is not exported as a named type.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getPrivateJSON decodes key into v. It reports false when the key is missing
+// or unreadable, e.g. in state written by an older provider version.
+func getPrivateJSON(ctx context.Context, p privateStateGetter, key string, v any) bool {
+	data, diags := p.GetKey(ctx, key)
+	if diags.HasError() || len(data) == 0 {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// setPrivateJSON stores v under key.
+func setPrivateJSON(ctx context.Context, p privateStateSetter, key string, v any) diag.Diagnostics {
+	data, err := json.Marshal(v)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Private State Error", err.Error())
+		return diags
+	}
+	return p.SetKey(ctx, key, data)
+}
+
+// payloadHash is a stable hash of an API payload; JSON sorts map keys.
+func payloadHash(payload any) string {
+	data, _ := json.Marshal(payload)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
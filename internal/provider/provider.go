@@ -2,26 +2,46 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ provider.Provider = &KineticpanelProvider{}
+var (
+	_ provider.Provider              = &KineticpanelProvider{}
+	_ provider.ProviderWithFunctions = &KineticpanelProvider{}
+)
 
 type KineticpanelProvider struct{ version string }
 
 type kineticpanelProviderModel struct {
 	Host           types.String `tfsdk:"host"`
 	APIKey         types.String `tfsdk:"api_key"`
+	APIKeyFile     types.String `tfsdk:"api_key_file"`
+	APIKeyCommand  types.List   `tfsdk:"api_key_command"`
 	UseApplication types.Bool   `tfsdk:"use_application"`
+	WaitForReady   types.Bool   `tfsdk:"wait_for_ready"`
+	ReadyTimeout   types.Int64  `tfsdk:"wait_for_ready_timeout"`
+	VerifyImages   types.Bool   `tfsdk:"verify_docker_images"`
+	MockDir        types.String `tfsdk:"mock_responses_dir"`
+	RegistryCreds  []struct {
+		Registry types.String `tfsdk:"registry"`
+		Username types.String `tfsdk:"username"`
+		Password types.String `tfsdk:"password"`
+	} `tfsdk:"registry_credentials"`
 }
 
 func init() {
@@ -41,17 +61,70 @@ func (p *KineticpanelProvider) Schema(_ context.Context, _ provider.SchemaReques
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Optional:    true,
-				Description: "Base URL of the panel. Defaults to `https://kineticpanel.net` if not set. Using other hosts may not work reliably.",
+				Description: "Base URL of the panel. Defaults to `https://kineticpanel.net` if not set. Using other hosts may not work reliably. A missing scheme defaults to `https://`, and a trailing slash or `/api`, `/api/client` or `/api/application` suffix is removed.",
 			},
 			"api_key": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "Client or Application API key.",
+				Description: "Client or Application API key. One of `api_key`, `api_key_file`, `api_key_command` or the `KINETICPANEL_API_KEY` environment variable is required, checked in that order.",
+			},
+			"api_key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file holding the API key, e.g. one written by a secrets agent. Surrounding whitespace is ignored.",
+			},
+			"api_key_command": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Command and arguments of a credential helper that prints the API key on stdout, e.g. `[\"pass\", \"show\", \"kineticpanel\"]`. It runs without a shell and must finish within 30 seconds.",
 			},
 			"use_application": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Use Application API (admin tasks, e.g. creating servers). Default: true. Set false for Client API.",
 			},
+			"wait_for_ready": schema.BoolAttribute{
+				Optional: true,
+				Description: "Client API only: when the panel rejects a change to a server with 409 because it is still installing, transferring or restoring a backup, wait and retry instead of failing. " +
+					"Useful when servers are created in the same apply. Suspended servers still fail right away. Default: false.",
+			},
+			"wait_for_ready_timeout": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(10, 7200),
+				},
+				Description: "How long `wait_for_ready` retries, in seconds. Default: 600.",
+			},
+			"verify_docker_images": schema.BoolAttribute{
+				Optional: true,
+				Description: "Check at plan time that `docker_image` values exist in their registry, and warn when they do not, so a typo in a tag does not leave a server failing to start. " +
+					"Terraform must be able to reach the registries. Images are checked anonymously unless `registry_credentials` has an entry for the registry. Default: false.",
+			},
+			"mock_responses_dir": schema.StringAttribute{
+				Optional: true,
+				Description: "Answer every API request from files in this directory instead of the panel, for `terraform test` runs without network access. " +
+					"The response to `GET /api/client/servers/1a2b3c4d` is read from `<dir>/GET/api/client/servers/1a2b3c4d.json`; name the file `1a2b3c4d.404.json` to answer with another status. " +
+					"Query strings are ignored. Requests without a file fail with 404 for GET and succeed with 204 otherwise. " +
+					"No API key is needed, and `verify_docker_images` is ignored.",
+			},
+			"registry_credentials": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Credentials for private registries, used by `verify_docker_images`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"registry": schema.StringAttribute{
+							Required:    true,
+							Description: "Registry host as in image references, e.g. `ghcr.io`; `docker.io` for Docker Hub.",
+						},
+						"username": schema.StringAttribute{
+							Required: true,
+						},
+						"password": schema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "Password or access token.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -70,14 +143,20 @@ func (p *KineticpanelProvider) Configure(ctx context.Context, req provider.Confi
 	if host == "" {
 		host = "https://kineticpanel.net"
 	}
+	host, err := normalizeHost(host)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("host"), "Invalid host", err.Error()+". Use the panel URL, e.g. https://panel.example.com.")
+		return
+	}
 
-	if config.Host.ValueString() != "" && !strings.EqualFold(strings.TrimRight(config.Host.ValueString(), "/"), "https://kineticpanel.net") {
+	if config.Host.ValueString() != "" && !strings.EqualFold(host, "https://kineticpanel.net") {
 		resp.Diagnostics.AddWarning("Non-standard host", "This provider is optimized for https://kineticpanel.net. Other instances may have compatibility issues.")
 	}
 
-	apiKey := config.APIKey.ValueString()
-	if apiKey == "" {
-		apiKey = os.Getenv("KINETICPANEL_API_KEY")
+	apiKey, err := resolveAPIKey(ctx, config)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid API key configuration", err.Error())
+		return
 	}
 
 	useApp := true
@@ -85,13 +164,55 @@ func (p *KineticpanelProvider) Configure(ctx context.Context, req provider.Confi
 		useApp = config.UseApplication.ValueBool()
 	}
 
+	mockDir := config.MockDir.ValueString()
+	if mockDir != "" {
+		if info, err := os.Stat(mockDir); err != nil || !info.IsDir() {
+			resp.Diagnostics.AddAttributeError(path.Root("mock_responses_dir"), "Invalid mock_responses_dir",
+				fmt.Sprintf("%q is not a directory.", mockDir))
+			return
+		}
+		if apiKey == "" {
+			apiKey = "mock"
+		}
+	}
+
 	if apiKey == "" {
-		resp.Diagnostics.AddError("Missing configuration", "api_key is required")
+		resp.Diagnostics.AddError("Missing configuration", "Set api_key, api_key_file, api_key_command or the KINETICPANEL_API_KEY environment variable.")
+		return
+	}
+
+	want := scopeClient
+	if useApp {
+		want = scopeApplication
+	}
+	if got := keyScope(apiKey); got != "" && got != want {
+		resp.Diagnostics.AddAttributeError(path.Root("use_application"), "API Key Does Not Match use_application",
+			fmt.Sprintf("The API key looks like a %s API key, but use_application selects the %s API. Either set use_application to match the key, or: %s", got, want, scopeGuidance(want)))
 		return
 	}
 
 	client := NewClient(host, apiKey, useApp)
-	tflog.Info(ctx, "Provider configured", map[string]any{"host": host, "use_application": useApp})
+	if mockDir != "" {
+		client.httpClient.Transport = mockTransport{dir: mockDir}
+	}
+	if config.VerifyImages.ValueBool() && mockDir == "" {
+		creds := map[string]registryCredential{}
+		for _, c := range config.RegistryCreds {
+			registry := strings.ToLower(c.Registry.ValueString())
+			if registry == "index.docker.io" || registry == "registry-1.docker.io" {
+				registry = "docker.io"
+			}
+			creds[registry] = registryCredential{Username: c.Username.ValueString(), Password: c.Password.ValueString()}
+		}
+		client.ImageChecker = newImageChecker(creds)
+	}
+	if config.WaitForReady.ValueBool() {
+		client.WaitForReady = 600 * time.Second
+		if !config.ReadyTimeout.IsNull() {
+			client.WaitForReady = time.Duration(config.ReadyTimeout.ValueInt64()) * time.Second
+		}
+	}
+	tflog.Info(ctx, "Provider configured", map[string]any{"host": host, "use_application": useApp, "mock_responses_dir": mockDir})
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -102,10 +223,41 @@ func (p *KineticpanelProvider) Resources(_ context.Context) []func() resource.Re
 		NewServerResource,
 		NewServerPowerResource,
 		NewServerCommandResource,
+		NewServerCommandSequenceResource,
+		NewServerWaitResource,
 		NewServerRenameResource,
 		NewServerReinstallResource,
+		NewServerRebuildResource,
+		NewServerCloneResource,
+		NewServerBlueGreenResource,
+		NewServerAdminReinstallResource,
 		NewServerDockerImageResource,
 		NewServerStartupVariableResource,
+		NewServerStartupVariablesResource,
+		NewServerBackupResource,
+		NewServerBackupScheduleResource,
+		NewServerBackupRetentionResource,
+		NewServerRestartScheduleResource,
+		NewServerFileResource,
+		NewServerFileUploadResource,
+		NewServerFileCopyResource,
+		NewServerFileDeleteResource,
+		NewEggResource,
+		NewServerEggResource,
+		NewServerStartupResource,
+		NewDatabaseHostResource,
+		NewServerDatabaseResource,
+		NewNodeResource,
+		NewNodeAllocationsResource,
+		NewServerAllocationsResource,
+		NewServerBuildResource,
+		NewServerDetailsResource,
+		NewUserResource,
+		NewUserCredentialsResetResource,
+		NewMinecraftPropertiesResource,
+		NewMinecraftWhitelistResource,
+		NewMinecraftOpsResource,
+		NewMinecraftEULAResource,
 	}
 }
 
@@ -113,8 +265,39 @@ func (p *KineticpanelProvider) DataSources(_ context.Context) []func() datasourc
 	return []func() datasource.DataSource{
 		NewServerDataSource,
 		NewServerUtilizationDataSource,
+		NewServersUtilizationDataSource,
+		NewServerStatusDataSource,
+		NewServerEggDataSource,
+		NewServerFileDataSource,
+		NewServerDirectoryUsageDataSource,
 		NewServerStartupDataSource,
+		NewServerVariablesDataSource,
+		NewServerDatabasesDataSource,
+		NewEggDockerImagesDataSource,
+		NewEggExportDataSource,
+		NewNestsDataSource,
+		NewNodeCapacityDataSource,
+		NewNodeSystemInfoDataSource,
+		NewNodeDeployDataSource,
+		NewPanelDataSource,
 		NewServerActivityLogsDataSource,
+		NewServerConsoleLogsDataSource,
+		NewServerConsoleCaptureDataSource,
+		NewServersDataSource,
+		NewApplicationServersDataSource,
+		NewUsersDataSource,
+	}
+}
+
+func (p *KineticpanelProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewSrvRecordFunction,
+		NewParseAddressFunction,
+		NewValidateCronFunction,
+		NewCronNextRunsFunction,
+		NewNormalizeImageFunction,
+		NewParseSizeFunction,
+		NewHumanizeBytesFunction,
 	}
 }
 
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// redactedKeys are JSON fields whose values never reach the debug log. A key
+// is redacted when it equals or contains one of these (case-insensitive).
+var redactedKeys = []string{
+	"password",
+	"secret",
+	"token",
+	"api_key",
+	"apikey",
+	"authorization",
+	"private_key",
+	"passphrase",
+}
+
+// unredactedKeys match redactedKeys but hold no secret, and are kept for
+// debugging.
+var unredactedKeys = map[string]bool{
+	"daemon_token_id": true,
+	"token_id":        true,
+	"password_set":    true,
+}
+
+// apiKeyPattern matches panel API keys wherever they appear in a string value.
+var apiKeyPattern = regexp.MustCompile(`\b(ptl[ac]|peli|plcn)_[A-Za-z0-9]+`)
+
+// sensitiveKey reports whether the value of a JSON field must be redacted.
+func sensitiveKey(key string) bool {
+	k := strings.ToLower(key)
+	if unredactedKeys[k] {
+		return false
+	}
+	for _, s := range redactedKeys {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactBody returns body as it may be logged: JSON with sensitive values
+// replaced by "***", or only the size for anything that is not JSON (e.g.
+// uploaded files), since its contents cannot be inspected field by field.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	// UseNumber keeps large IDs as sent instead of rounding them to float64.
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		return fmt.Sprintf("(%d bytes, not JSON)", len(body))
+	}
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return fmt.Sprintf("(%d bytes)", len(body))
+	}
+	return string(out)
+}
+
+// redactValue walks a decoded JSON value and redacts it in place.
+func redactValue(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			if sensitiveKey(k) && val != nil {
+				t[k] = "***"
+				continue
+			}
+			t[k] = redactValue(val)
+		}
+	case []any:
+		for i, val := range t {
+			t[i] = redactValue(val)
+		}
+	case string:
+		return apiKeyPattern.ReplaceAllString(t, "${1}_***")
+	}
+	return v
+}
@@ -0,0 +1,31 @@
+package provider
+
+import "testing"
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"empty", ``, ``},
+		{"not JSON", `hello`, `(5 bytes, not JSON)`},
+		{"trailing data", `{"a":1} {"b":2}`, `(15 bytes, not JSON)`},
+		{"password", `{"username":"alex","password":"hunter2"}`, `{"password":"***","username":"alex"}`},
+		{"case and substrings", `{"Root_Password":"x","clientSecret":"y","API_KEY":"z"}`, `{"API_KEY":"***","Root_Password":"***","clientSecret":"***"}`},
+		{"nested", `{"attributes":{"name":"db","relationships":{"password":{"password":"p"}}}}`, `{"attributes":{"name":"db","relationships":{"password":"***"}}}`},
+		{"arrays", `{"data":[{"token":"a"},{"token":"b"}]}`, `{"data":[{"token":"***"},{"token":"***"}]}`},
+		{"environment", `{"environment":{"DB_PASSWORD":"p","SERVER_PORT":"25565"}}`, `{"environment":{"DB_PASSWORD":"***","SERVER_PORT":"25565"}}`},
+		{"kept keys", `{"token_id":"abc","daemon_token_id":"def","password_set":true}`, `{"daemon_token_id":"def","password_set":true,"token_id":"abc"}`},
+		{"daemon token", `{"daemon_token":"secret"}`, `{"daemon_token":"***"}`},
+		{"null secret", `{"password":null}`, `{"password":null}`},
+		{"api keys in values", `{"detail":"key ptlc_AbC123 and peli_x9 are invalid"}`, `{"detail":"key ptlc_*** and peli_*** are invalid"}`},
+		{"api key in array", `["ptla_abc"]`, `["ptla_***"]`},
+		{"large numbers", `{"id":12345678901234567890,"size":1.5}`, `{"id":12345678901234567890,"size":1.5}`},
+	}
+	for _, tt := range tests {
+		if got := redactBody([]byte(tt.body)); got != tt.want {
+			t.Errorf("redactBody(%s) = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
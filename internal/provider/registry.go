@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Opt-in check that an image exists in its registry, using the Docker
+// Registry HTTP API v2.
+
+// registryCredential authenticates against one registry.
+type registryCredential struct {
+	Username string
+	Password string
+}
+
+// imageChecker checks images against their registries. Credentials are keyed
+// by registry host as it appears in normalized references (`docker.io`,
+// `ghcr.io`, ...).
+type imageChecker struct {
+	httpClient  *http.Client
+	credentials map[string]registryCredential
+}
+
+// errImageNotFound is returned when the registry has no such manifest, and
+// errImageDenied when it refuses to say without (other) credentials.
+var (
+	errImageNotFound = errors.New("manifest not found")
+	errImageDenied   = errors.New("access denied")
+)
+
+// manifestAccept lists the manifest formats registries may answer with.
+var manifestAccept = strings.Join([]string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}, ", ")
+
+func newImageChecker(credentials map[string]registryCredential) *imageChecker {
+	return &imageChecker{
+		httpClient:  &http.Client{Timeout: 15 * time.Second},
+		credentials: credentials,
+	}
+}
+
+// check reports whether image exists. It returns errImageNotFound when the
+// registry answers 404, errImageDenied when it refuses access, and other
+// errors when it could not be asked.
+func (c *imageChecker) check(ctx context.Context, image string) error {
+	ref, err := normalizeImage(image)
+	if err != nil {
+		return err
+	}
+	domain, rest, _ := strings.Cut(ref, "/")
+	repo, reference := rest, ""
+	if name, digest, ok := strings.Cut(rest, "@"); ok {
+		repo, reference = name, digest
+	} else if i := strings.LastIndex(rest, ":"); i >= 0 {
+		repo, reference = rest[:i], rest[i+1:]
+	}
+	host := domain
+	if domain == "docker.io" {
+		host = "registry-1.docker.io"
+	}
+	manifestURL := fmt.Sprintf("https://%s/v2/%s/manifests/%s", host, repo, reference)
+	cred, hasCred := c.credentials[domain]
+
+	resp, err := c.head(ctx, manifestURL, "")
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		auth, err := c.authorize(ctx, resp.Header.Get("WWW-Authenticate"), cred, hasCred)
+		if err != nil {
+			return err
+		}
+		if resp, err = c.head(ctx, manifestURL, auth); err != nil {
+			return err
+		}
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return errImageNotFound
+	case http.StatusUnauthorized, http.StatusForbidden:
+		if hasCred {
+			return fmt.Errorf("%w: %s refused the configured credentials (HTTP %d)", errImageDenied, domain, resp.StatusCode)
+		}
+		// Private repositories and missing ones look alike to anonymous users.
+		return fmt.Errorf("%w: %s requires credentials for %s (HTTP %d); it is private or does not exist", errImageDenied, domain, repo, resp.StatusCode)
+	default:
+		return fmt.Errorf("%s answered HTTP %d", domain, resp.StatusCode)
+	}
+}
+
+// head sends a HEAD request for a manifest.
+func (c *imageChecker) head(ctx context.Context, target, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", manifestAccept)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return resp, nil
+}
+
+// authorize answers a WWW-Authenticate challenge with an Authorization
+// header: Basic with the credentials, or a Bearer token from the registry's
+// token service, anonymous without credentials.
+func (c *imageChecker) authorize(ctx context.Context, challenge string, cred registryCredential, hasCred bool) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if !hasCred {
+			return "", fmt.Errorf("registry requires credentials")
+		}
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(cred.Username+":"+cred.Password)), nil
+	case "bearer":
+	default:
+		return "", fmt.Errorf("unsupported registry authentication %q", scheme)
+	}
+
+	values := parseChallengeParams(params)
+	realm, err := url.Parse(values["realm"])
+	if err != nil || realm.Scheme == "" {
+		return "", fmt.Errorf("registry sent an invalid token realm %q", values["realm"])
+	}
+	q := realm.Query()
+	for _, k := range []string{"service", "scope"} {
+		if values[k] != "" {
+			q.Set(k, values[k])
+		}
+	}
+	realm.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if hasCred {
+		req.SetBasicAuth(cred.Username, cred.Password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("registry token service answered HTTP %d", resp.StatusCode)
+	}
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&token); err != nil {
+		return "", fmt.Errorf("registry token service: %w", err)
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	return "Bearer " + token.Token, nil
+}
+
+// parseChallengeParams parses `realm="...",service="...",scope="..."`.
+func parseChallengeParams(s string) map[string]string {
+	values := map[string]string{}
+	for s != "" {
+		s = strings.TrimLeft(s, ", ")
+		key, rest, ok := strings.Cut(s, "=")
+		if !ok {
+			break
+		}
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				break
+			}
+			value, s = rest[1:end+1], rest[end+2:]
+		} else {
+			value, s, _ = strings.Cut(rest, ",")
+		}
+		values[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+	return values
+}
+
+// warnMissingImage adds a warning when the client is set up to verify images
+// and image does not exist in its registry. Failures to check are only logged.
+func warnMissingImage(ctx context.Context, diags *diag.Diagnostics, client *Client, p path.Path, image string) {
+	if client == nil || client.ImageChecker == nil {
+		return
+	}
+	err := client.ImageChecker.check(ctx, image)
+	switch {
+	case err == nil:
+	case errors.Is(err, errImageNotFound):
+		diags.AddAttributeWarning(p, "Docker Image Not Found",
+			fmt.Sprintf("The registry has no image %q. Check the name and tag for typos; a server with a missing image is stuck failing to start.", image))
+	case errors.Is(err, errImageDenied):
+		diags.AddAttributeWarning(p, "Docker Image Not Verified",
+			fmt.Sprintf("Could not verify image %q: %v. Check the repository name, or add credentials for the registry to the provider's registry_credentials.", image, err))
+	default:
+		tflog.Warn(ctx, "Could not verify docker image", map[string]any{"image": image, "error": err.Error()})
+	}
+}
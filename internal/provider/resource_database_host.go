@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &DatabaseHostResource{}
+	_ resource.ResourceWithImportState = &DatabaseHostResource{}
+)
+
+// DatabaseHostResource manages a database host as an administrator.
+type DatabaseHostResource struct {
+	resourceBase
+}
+
+// databaseHostModel holds the resource state.
+type databaseHostModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Host         types.String `tfsdk:"host"`
+	Port         types.Int64  `tfsdk:"port"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"` // write-only; never read back
+	MaxDatabases types.Int64  `tfsdk:"max_databases"`
+	NodeID       types.Int64  `tfsdk:"node_id"`
+}
+
+func NewDatabaseHostResource() resource.Resource {
+	return &DatabaseHostResource{resourceBase: newResourceBase("kineticpanel_database_host", scopeApplication)}
+}
+
+func (r *DatabaseHostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_host"
+}
+
+func (r *DatabaseHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", "Expected the numeric database host ID.")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *DatabaseHostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a MySQL/MariaDB host that server databases are created on (Application API). " +
+			"Linking it to a node with `node_id` lets `kineticpanel_server_database` pick it for servers on that node with `prefer_node_host`. " +
+			"The password is not read back, so changes made in the panel are not detected. Destroying the resource deletes the host, which the panel refuses while it has databases.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Database host ID.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Display name.",
+			},
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "Address the panel and servers connect to.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{portValidator{}},
+				Description: "Port. Default: 3306.",
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "Account the panel creates databases and users with.",
+			},
+			"password": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of `username`.",
+			},
+			"max_databases": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Maximum number of databases on the host. Unset means unlimited.",
+			},
+			"node_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Node the host is linked to, typically the one it runs next to.",
+			},
+		},
+	}
+}
+
+// payload builds the request body. node_ids is sent alongside node_id for
+// panels that link hosts to several nodes.
+func (m databaseHostModel) payload() map[string]any {
+	port := int64(3306)
+	if !m.Port.IsNull() {
+		port = m.Port.ValueInt64()
+	}
+	var maxDatabases any
+	if !m.MaxDatabases.IsNull() {
+		maxDatabases = m.MaxDatabases.ValueInt64()
+	}
+	var nodeID any
+	nodeIDs := []int64{}
+	if !m.NodeID.IsNull() {
+		nodeID = m.NodeID.ValueInt64()
+		nodeIDs = append(nodeIDs, m.NodeID.ValueInt64())
+	}
+	return map[string]any{
+		"name":          m.Name.ValueString(),
+		"host":          m.Host.ValueString(),
+		"port":          port,
+		"username":      m.Username.ValueString(),
+		"password":      m.Password.ValueString(),
+		"max_databases": maxDatabases,
+		"node_id":       nodeID,
+		"node_ids":      nodeIDs,
+	}
+}
+
+// apply copies h into m, keeping the configured spelling of defaults.
+func (m *databaseHostModel) apply(h databaseHost) {
+	m.ID = types.Int64Value(h.ID)
+	m.Name = types.StringValue(h.Name)
+	m.Host = types.StringValue(h.Host)
+	if !m.Port.IsNull() || h.Port != 3306 {
+		m.Port = types.Int64Value(h.Port)
+	}
+	m.Username = types.StringValue(h.Username)
+	m.MaxDatabases = types.Int64Null()
+	if h.MaxDatabases != nil && *h.MaxDatabases > 0 {
+		m.MaxDatabases = types.Int64Value(*h.MaxDatabases)
+	}
+	switch {
+	case h.NodeID != nil:
+		m.NodeID = types.Int64Value(*h.NodeID)
+	case len(h.NodeIDs) == 1:
+		m.NodeID = types.Int64Value(h.NodeIDs[0])
+	case len(h.NodeIDs) > 1 && !m.NodeID.IsNull() && h.linkedTo(m.NodeID.ValueInt64()):
+		// Linked to more nodes in the panel; the configured one is kept.
+	default:
+		m.NodeID = types.Int64Null()
+	}
+}
+
+func (r *DatabaseHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan databaseHostModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.client.Post("/database-hosts", plan.payload())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create database host %s: %v", plan.Name.ValueString(), err))
+		return
+	}
+	host, err := parseDatabaseHost(body)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create database host %s: %v", plan.Name.ValueString(), err))
+		return
+	}
+	plan.apply(host)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DatabaseHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state databaseHostModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host, err := getDatabaseHost(r.client, state.ID.ValueInt64())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch database host %d: %v", state.ID.ValueInt64(), err))
+		return
+	}
+	state.apply(host)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *DatabaseHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state databaseHostModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostID := state.ID.ValueInt64()
+	body, err := r.client.Patch(fmt.Sprintf("/database-hosts/%d", hostID), plan.payload())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update database host %d: %v", hostID, err))
+		return
+	}
+	host, err := parseDatabaseHost(body)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update database host %d: %v", hostID, err))
+		return
+	}
+	plan.apply(host)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DatabaseHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state databaseHostModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostID := state.ID.ValueInt64()
+	if err := r.client.Delete(fmt.Sprintf("/database-hosts/%d", hostID)); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete database host %d (it may still have databases): %v", hostID, err))
+	}
+}
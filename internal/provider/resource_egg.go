@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &EggResource{}
+	_ resource.ResourceWithModifyPlan  = &EggResource{}
+	_ resource.ResourceWithImportState = &EggResource{}
+)
+
+// EggResource imports an egg file into a nest and keeps it in sync with the file.
+type EggResource struct {
+	resourceBase
+}
+
+// eggModel holds the resource state.
+type eggModel struct {
+	NestID         types.Int64  `tfsdk:"nest_id"`
+	EggJSON        types.String `tfsdk:"egg_json"`
+	UpstreamURL    types.String `tfsdk:"upstream_url"`
+	AutoUpdate     types.Bool   `tfsdk:"auto_update"`
+	UpstreamSHA256 types.String `tfsdk:"upstream_sha256"` // of the imported file
+	ID             types.Int64  `tfsdk:"id"`
+	UUID           types.String `tfsdk:"uuid"`
+	Name           types.String `tfsdk:"name"`
+}
+
+// upstreamHTTPClient fetches egg files from upstream_url.
+var upstreamHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// maxEggFileBytes caps the size of an egg file; real ones are a few KiB.
+const maxEggFileBytes = 1 << 20
+
+func NewEggResource() resource.Resource {
+	return &EggResource{resourceBase: newResourceBase("kineticpanel_egg", scopeApplication)}
+}
+
+func (r *EggResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_egg"
+}
+
+func (r *EggResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importComposite(ctx, req, resp, int64Part("nest_id", "<nest_id>"), int64Part("id", "<egg_id>"))
+}
+
+func (r *EggResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Imports an egg file into a nest (Application API) and re-imports it when the file changes, e.g. to keep community eggs up to date. " +
+			"The file comes from `egg_json` or is downloaded from `upstream_url`; with `auto_update` every plan downloads it again and re-imports it when its SHA-256 changed. " +
+			"Re-importing updates the egg in place, so servers using it keep their settings. " +
+			"The panel's Application API must support egg imports; stock Pterodactyl only imports eggs in the admin UI and answers 404.",
+		Attributes: map[string]schema.Attribute{
+			"nest_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Nest to import the egg into.",
+			},
+			"egg_json": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("upstream_url")),
+				},
+				Description: "Egg file contents, e.g. `file(\"egg-paper.json\")` or the `json` of `kineticpanel_egg_export`.",
+			},
+			"upstream_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the egg file, e.g. a raw GitHub URL of a parkervcp egg. Downloaded on create and whenever the URL changes.",
+			},
+			"auto_update": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Download `upstream_url` on every plan and re-import the egg when the file changed. Default: false.",
+			},
+			"upstream_sha256": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "SHA-256 of the imported egg file.",
+			},
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Egg ID.",
+			},
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Egg name from the file.",
+			},
+		},
+	}
+}
+
+// fetchEggFile downloads an egg file and checks that it is JSON.
+func fetchEggFile(url string) ([]byte, error) {
+	resp, err := upstreamHTTPClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: HTTP %d", url, resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxEggFileBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxEggFileBytes {
+		return nil, fmt.Errorf("%s is larger than %d bytes", url, maxEggFileBytes)
+	}
+	if !json.Valid(body) {
+		return nil, fmt.Errorf("%s is not JSON: %s", url, bodySnippet(body))
+	}
+	return body, nil
+}
+
+// eggFile returns the egg file the plan asks for.
+func eggFile(plan eggModel) ([]byte, error) {
+	if !plan.EggJSON.IsNull() {
+		data := []byte(plan.EggJSON.ValueString())
+		if !json.Valid(data) {
+			return nil, fmt.Errorf("egg_json is not valid JSON")
+		}
+		return data, nil
+	}
+	return fetchEggFile(plan.UpstreamURL.ValueString())
+}
+
+// importEggFile uploads an egg file like the admin UI does, creating a new
+// egg in the nest or, with eggID, replacing that egg's definition.
+func importEggFile(client *Client, nestID, eggID int64, data []byte) (eggAttributes, error) {
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	part, err := form.CreateFormFile("import_file_upload", "egg.json")
+	if err != nil {
+		return eggAttributes{}, err
+	}
+	if _, err := part.Write(data); err != nil {
+		return eggAttributes{}, err
+	}
+	if err := form.Close(); err != nil {
+		return eggAttributes{}, err
+	}
+
+	pth := fmt.Sprintf("/nests/%d/eggs/import", nestID)
+	if eggID != 0 {
+		pth = fmt.Sprintf("/nests/%d/eggs/%d/import", nestID, eggID)
+	}
+	body, err := client.PostRaw(pth, buf.Bytes(), form.FormDataContentType())
+	if err != nil {
+		return eggAttributes{}, err
+	}
+	var apiResp struct {
+		Attributes eggAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return eggAttributes{}, fmt.Errorf("JSON parse error: %w", err)
+	}
+	return apiResp.Attributes, nil
+}
+
+// ModifyPlan downloads the upstream file when auto_update is on and plans a
+// re-import when its hash differs from the imported one.
+func (r *EggResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var plan, state eggModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.EggJSON.Equal(state.EggJSON) || !plan.UpstreamURL.Equal(state.UpstreamURL) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("upstream_sha256"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), types.StringUnknown())...)
+		return
+	}
+	if !plan.AutoUpdate.ValueBool() || plan.UpstreamURL.IsNull() || plan.UpstreamURL.IsUnknown() {
+		return
+	}
+	data, err := fetchEggFile(plan.UpstreamURL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("upstream_url"), "Upstream Egg Unavailable",
+			fmt.Sprintf("Could not check the upstream egg for updates, so the egg is left as it is: %v", err))
+		return
+	}
+	if sha256Hex(data) != state.UpstreamSHA256.ValueString() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("upstream_sha256"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), types.StringUnknown())...)
+	}
+}
+
+// apply imports the plan's egg file and stores the result in plan.
+func (r *EggResource) apply(plan *eggModel, eggID int64) error {
+	data, err := eggFile(*plan)
+	if err != nil {
+		return err
+	}
+	egg, err := importEggFile(r.client, plan.NestID.ValueInt64(), eggID, data)
+	if err != nil {
+		return err
+	}
+	if egg.ID == 0 {
+		egg.ID = eggID
+	}
+	plan.ID = types.Int64Value(egg.ID)
+	plan.UUID = types.StringValue(egg.UUID)
+	plan.Name = types.StringValue(egg.Name)
+	plan.UpstreamSHA256 = types.StringValue(sha256Hex(data))
+	return nil
+}
+
+func (r *EggResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan eggModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(&plan, 0); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to import egg into nest %d: %v", plan.NestID.ValueInt64(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *EggResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state eggModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	egg, err := getEgg(r.client, state.NestID.ValueInt64(), state.ID.ValueInt64())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch egg %d: %v", state.ID.ValueInt64(), err))
+		return
+	}
+	state.UUID = types.StringValue(egg.UUID)
+	state.Name = types.StringValue(egg.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *EggResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state eggModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eggID := state.ID.ValueInt64()
+	if plan.UpstreamSHA256.IsUnknown() {
+		if err := r.apply(&plan, eggID); err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to re-import egg %d: %v", eggID, err))
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *EggResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state eggModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pth := "/nests/" + strconv.FormatInt(state.NestID.ValueInt64(), 10) + "/eggs/" + strconv.FormatInt(state.ID.ValueInt64(), 10)
+	if err := r.client.Delete(pth); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete egg %d (servers may still use it): %v", state.ID.ValueInt64(), err))
+	}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &MinecraftEULAResource{}
+
+// MinecraftEULAResource accepts the Minecraft EULA by writing eula.txt.
+type MinecraftEULAResource struct {
+	resourceBase
+}
+
+// minecraftEULAModel holds the resource state.
+type minecraftEULAModel struct {
+	ServerID       types.String `tfsdk:"server_id"`
+	InstallTimeout types.Int64  `tfsdk:"install_timeout_seconds"` // default 900
+	ID             types.String `tfsdk:"id"`                      // synthetic: "<server_id>-eula"
+}
+
+func NewMinecraftEULAResource() resource.Resource {
+	return &MinecraftEULAResource{resourceBase: newResourceBase("kineticpanel_minecraft_eula", scopeClient)}
+}
+
+func (r *MinecraftEULAResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_minecraft_eula"
+}
+
+func (r *MinecraftEULAResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importComposite(ctx, req, resp, serverIDPart)
+}
+
+func (r *MinecraftEULAResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Accepts the Minecraft EULA by setting `eula=true` in `eula.txt` (Client API), so the first boot does not stop at the EULA prompt. " +
+			"Create waits for the server install to finish and leaves an already accepted file untouched. " +
+			"If the file no longer accepts the EULA, the next plan writes it again. Destroying the resource leaves the file as is.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"install_timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the server install to finish before writing the file. Default: 900.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-eula`).",
+			},
+		},
+	}
+}
+
+func (r *MinecraftEULAResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan minecraftEULAModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := plan.ServerID.ValueString()
+	timeout := 900 * time.Second
+	if !plan.InstallTimeout.IsNull() {
+		timeout = time.Duration(plan.InstallTimeout.ValueInt64()) * time.Second
+	}
+	if err := waitForClientInstall(ctx, r.client, serverID, timeout); err != nil {
+		resp.Diagnostics.AddError("Install Wait Failed", err.Error())
+		return
+	}
+
+	body, err := readServerFile(r.client, serverID, "eula.txt")
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Failed to read eula.txt", err.Error())
+		return
+	}
+	if parseProperties(string(body))["eula"] != "true" {
+		content := mergeProperties(string(body), map[string]string{"eula": "true"})
+		if err := writeServerFile(r.client, serverID, "eula.txt", []byte(content)); err != nil {
+			resp.Diagnostics.AddError("Failed to write eula.txt", err.Error())
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(serverID + "-eula")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *MinecraftEULAResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state minecraftEULAModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := readServerFile(r.client, state.ServerID.ValueString(), "eula.txt")
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Failed to read eula.txt", err.Error())
+		return
+	}
+	// A missing or reset file plans a fresh create, which accepts the EULA again.
+	if parseProperties(string(body))["eula"] != "true" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.ServerID.ValueString() + "-eula")
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *MinecraftEULAResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only install_timeout_seconds can change in place, and it matters on create only.
+	var plan minecraftEULAModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *MinecraftEULAResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: revoking the EULA would only stop the server from booting
+	resp.State.RemoveResource(ctx)
+}
+
+// clientServerInstalling reports whether the server's install script is running.
+func clientServerInstalling(client *Client, serverID string) (bool, error) {
+	body, err := client.Get("/servers/" + serverID)
+	if err != nil {
+		return false, err
+	}
+	var apiResp struct {
+		Attributes clientServerAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return false, err
+	}
+	return apiResp.Attributes.IsInstalling, nil
+}
+
+// waitForClientInstall polls the Client API until the server is no longer installing.
+func waitForClientInstall(ctx context.Context, client *Client, serverID string, timeout time.Duration) error {
+	return poll(ctx, pollOptions{
+		What:    "server install",
+		Fields:  map[string]any{"server_id": serverID},
+		Timeout: timeout,
+		TimeoutError: func(string) error {
+			return fmt.Errorf("server %s was still installing after %s", serverID, timeout)
+		},
+	}, func() (bool, string, error) {
+		installing, err := clientServerInstalling(client, serverID)
+		if err != nil {
+			return false, "", err
+		}
+		return !installing, "installing", nil
+	})
+}
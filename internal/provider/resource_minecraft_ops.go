@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &MinecraftOpsResource{}
+
+// MinecraftOpsResource manages operators in a Minecraft ops.json.
+type MinecraftOpsResource struct {
+	resourceBase
+}
+
+// minecraftOpsModel holds the resource state.
+type minecraftOpsModel struct {
+	ServerID    types.String `tfsdk:"server_id"`
+	Players     types.Set    `tfsdk:"players"` // player names managed by Terraform
+	Level       types.Int64  `tfsdk:"level"`   // permission level 1-4 (default 4)
+	BypassLimit types.Bool   `tfsdk:"bypasses_player_limit"`
+	OfflineMode types.Bool   `tfsdk:"offline_mode"` // derive UUIDs instead of asking Mojang
+	PlayerUUIDs types.Map    `tfsdk:"player_uuids"` // name -> UUID as written to the file
+	ID          types.String `tfsdk:"id"`           // synthetic: "<server_id>-ops"
+}
+
+func NewMinecraftOpsResource() resource.Resource {
+	return &MinecraftOpsResource{resourceBase: newResourceBase("kineticpanel_minecraft_ops", scopeClient)}
+}
+
+func (r *MinecraftOpsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_minecraft_ops"
+}
+
+func (r *MinecraftOpsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importComposite(ctx, req, resp, serverIDPart)
+}
+
+func (r *MinecraftOpsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages operators in the `ops.json` of a Minecraft server through the files API (Client API). " +
+			"Entries for players not listed in `players` are kept. Destroying the resource removes only the listed players. " +
+			"The server reads `ops.json` on start, so restart it to apply changes.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"players": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Player names to make operator. Names are matched case-insensitively.",
+			},
+			"level": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Operator permission level (1-4) given to every listed player. Default: 4.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4),
+				},
+			},
+			"bypasses_player_limit": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Let the listed players join when the server is full. Default: false.",
+			},
+			"offline_mode": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Derive offline-mode UUIDs instead of looking names up at Mojang. Use for servers with `online-mode=false`. Changing it resolves the UUIDs of all managed players again. Default: false.",
+			},
+			"player_uuids": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "UUID of each managed player, keyed by name.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-ops`).",
+			},
+		},
+	}
+}
+
+func (r *MinecraftOpsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan minecraftOpsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-ops")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *MinecraftOpsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state minecraftOpsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := loadPlayerList(r.client, state.ServerID.ValueString(), "ops.json")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read ops.json", err.Error())
+		return
+	}
+
+	// After import nothing is managed yet, so adopt the whole file.
+	var managed []string
+	if state.Players.IsNull() {
+		for _, e := range entries {
+			managed = append(managed, e.name())
+		}
+	} else {
+		resp.Diagnostics.Append(state.Players.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	players, uuids, diags := observedPlayers(entries, managed)
+	resp.Diagnostics.Append(diags...)
+
+	// Level and limit bypass apply to every listed player; report any mismatch as drift.
+	for _, name := range managed {
+		e, ok := findPlayer(entries, name)
+		if !ok {
+			continue
+		}
+		if lvl, ok := e["level"].(float64); ok && int64(lvl) != opsLevel(state) {
+			state.Level = types.Int64Value(int64(lvl))
+		}
+		if bypass, ok := e["bypassesPlayerLimit"].(bool); ok && bypass != state.BypassLimit.ValueBool() {
+			state.BypassLimit = types.BoolValue(bypass)
+		}
+	}
+	state.Players = players
+	state.PlayerUUIDs = uuids
+	state.ID = types.StringValue(state.ServerID.ValueString() + "-ops")
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *MinecraftOpsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state minecraftOpsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous []string
+	resp.Diagnostics.Append(state.Players.ElementsAs(ctx, &previous, false)...)
+	modeChanged := plan.OfflineMode.ValueBool() != state.OfflineMode.ValueBool()
+	resp.Diagnostics.Append(r.apply(ctx, &plan, previous, modeChanged)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-ops")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *MinecraftOpsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state minecraftOpsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var names []string
+	resp.Diagnostics.Append(state.Players.ElementsAs(ctx, &names, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := state.ServerID.ValueString()
+	entries, err := loadPlayerList(r.client, serverID, "ops.json")
+	if err == nil {
+		err = savePlayerList(r.client, serverID, "ops.json", mergePlayers(entries, names, nil))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update ops.json", err.Error())
+	}
+}
+
+// apply writes the planned players, dropping players that were removed from
+// the configuration, and records their UUIDs in plan. Offline and online
+// UUIDs differ, so when offline_mode changed every UUID is resolved anew.
+func (r *MinecraftOpsResource) apply(ctx context.Context, plan *minecraftOpsModel, previous []string, modeChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var names []string
+	diags.Append(plan.Players.ElementsAs(ctx, &names, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	serverID := plan.ServerID.ValueString()
+	entries, err := loadPlayerList(r.client, serverID, "ops.json")
+	if err != nil {
+		diags.AddError("Failed to read ops.json", err.Error())
+		return diags
+	}
+
+	known := entries
+	if modeChanged {
+		known = nil
+	}
+	upsert := make([]playerEntry, 0, len(names))
+	for _, name := range names {
+		id, err := resolvePlayerUUID(r.client, known, name, plan.OfflineMode.ValueBool())
+		if err != nil {
+			diags.AddError("Player Lookup Failed", err.Error())
+			return diags
+		}
+		upsert = append(upsert, playerEntry{
+			"uuid":                id,
+			"name":                name,
+			"level":               opsLevel(*plan),
+			"bypassesPlayerLimit": plan.BypassLimit.ValueBool(),
+		})
+	}
+
+	merged := mergePlayers(entries, removedPlayers(previous, names), upsert)
+	if err := savePlayerList(r.client, serverID, "ops.json", merged); err != nil {
+		diags.AddError("Failed to update ops.json", err.Error())
+		return diags
+	}
+
+	_, uuids, d := observedPlayers(merged, names)
+	diags.Append(d...)
+	plan.PlayerUUIDs = uuids
+	return diags
+}
+
+func opsLevel(m minecraftOpsModel) int64 {
+	if m.Level.IsNull() || m.Level.IsUnknown() {
+		return 4
+	}
+	return m.Level.ValueInt64()
+}
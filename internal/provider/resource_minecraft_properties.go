@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &MinecraftPropertiesResource{}
+
+// MinecraftPropertiesResource manages individual keys of a Minecraft server.properties file.
+type MinecraftPropertiesResource struct {
+	resourceBase
+}
+
+// minecraftPropertiesModel holds the resource state.
+type minecraftPropertiesModel struct {
+	ServerID   types.String `tfsdk:"server_id"`
+	File       types.String `tfsdk:"file"`       // defaults to /server.properties
+	Properties types.Map    `tfsdk:"properties"` // only the keys managed by Terraform
+	ID         types.String `tfsdk:"id"`         // synthetic: "<server_id>-properties"
+}
+
+func NewMinecraftPropertiesResource() resource.Resource {
+	return &MinecraftPropertiesResource{resourceBase: newResourceBase("kineticpanel_minecraft_properties", scopeClient)}
+}
+
+func (r *MinecraftPropertiesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_minecraft_properties"
+}
+
+func (r *MinecraftPropertiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importComposite(ctx, req, resp, serverIDPart)
+}
+
+func (r *MinecraftPropertiesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages selected keys of a Minecraft `server.properties` file through the files API (Client API). " +
+			"Keys not listed in `properties` are left untouched, so the game server may keep rewriting the file. Destroying the resource leaves the file as is.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"file": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Path of the properties file relative to the server root. Default: `server.properties`.",
+			},
+			"properties": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Keys to set and their values (e.g. `motd`, `max-players`). Drift is detected per key.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-properties`).",
+			},
+		},
+	}
+}
+
+func (r *MinecraftPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan minecraftPropertiesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, plan); err != nil {
+		resp.Diagnostics.AddError("Failed to update server.properties", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-properties")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *MinecraftPropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state minecraftPropertiesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.load(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read server.properties", err.Error())
+		return
+	}
+
+	// After import nothing is managed yet, so adopt the whole file.
+	if state.Properties.IsNull() {
+		props, diags := stringMapValue(current)
+		resp.Diagnostics.Append(diags...)
+		state.Properties = props
+	} else {
+		// Only report keys Terraform manages; a missing key shows up as drift.
+		managed := map[string]string{}
+		resp.Diagnostics.Append(state.Properties.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		observed := map[string]string{}
+		for k := range managed {
+			if v, ok := current[k]; ok {
+				observed[k] = v
+			}
+		}
+		props, diags := stringMapValue(observed)
+		resp.Diagnostics.Append(diags...)
+		state.Properties = props
+	}
+
+	state.ID = types.StringValue(state.ServerID.ValueString() + "-properties")
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *MinecraftPropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan minecraftPropertiesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, plan); err != nil {
+		resp.Diagnostics.AddError("Failed to update server.properties", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-properties")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *MinecraftPropertiesResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: the previous values are unknown, so the file is left as is
+	resp.State.RemoveResource(ctx)
+}
+
+func propertiesFile(m minecraftPropertiesModel) string {
+	if m.File.IsNull() || m.File.ValueString() == "" {
+		return "server.properties"
+	}
+	return m.File.ValueString()
+}
+
+// load returns the parsed properties file; a missing file reads as empty.
+func (r *MinecraftPropertiesResource) load(m minecraftPropertiesModel) (map[string]string, error) {
+	body, err := readServerFile(r.client, m.ServerID.ValueString(), propertiesFile(m))
+	if err != nil {
+		if isNotFound(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	return parseProperties(string(body)), nil
+}
+
+// apply merges the planned keys into the current file and writes it back.
+func (r *MinecraftPropertiesResource) apply(ctx context.Context, plan minecraftPropertiesModel) error {
+	overrides := map[string]string{}
+	if diags := plan.Properties.ElementsAs(ctx, &overrides, false); diags.HasError() {
+		return fmt.Errorf("invalid properties: %v", diags)
+	}
+
+	serverID := plan.ServerID.ValueString()
+	file := propertiesFile(plan)
+	body, err := readServerFile(r.client, serverID, file)
+	if err != nil {
+		if !isNotFound(err) {
+			return err
+		}
+		body = nil
+	}
+
+	return writeServerFile(r.client, serverID, file, []byte(mergeProperties(string(body), overrides)))
+}
+
+// parseProperties reads `key=value` (or `key: value`) lines, skipping comments.
+func parseProperties(content string) map[string]string {
+	props := map[string]string{}
+	for _, line := range strings.Split(content, "\n") {
+		if k, v, ok := splitPropertyLine(line); ok {
+			props[k] = v
+		}
+	}
+	return props
+}
+
+func splitPropertyLine(line string) (string, string, bool) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "!") {
+		return "", "", false
+	}
+	i := strings.IndexAny(trimmed, "=:")
+	if i < 0 {
+		return trimmed, "", true
+	}
+	return strings.TrimSpace(trimmed[:i]), strings.TrimSpace(trimmed[i+1:]), true
+}
+
+// mergeProperties rewrites existing keys in place, keeping comments and order,
+// and appends keys that were not present yet in sorted order.
+func mergeProperties(content string, overrides map[string]string) string {
+	lines := strings.Split(strings.TrimRight(content, "\n"), "\n")
+	if content == "" {
+		lines = nil
+	}
+
+	seen := map[string]bool{}
+	for i, line := range lines {
+		k, _, ok := splitPropertyLine(line)
+		if !ok {
+			continue
+		}
+		if v, managed := overrides[k]; managed {
+			lines[i] = k + "=" + v
+			seen[k] = true
+		}
+	}
+
+	var missing []string
+	for k := range overrides {
+		if !seen[k] {
+			missing = append(missing, k)
+		}
+	}
+	sort.Strings(missing)
+	for _, k := range missing {
+		lines = append(lines, k+"="+overrides[k])
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestParseProperties(t *testing.T) {
+	content := "#Minecraft server properties\n" +
+		"! also a comment\n" +
+		"motd=A Minecraft Server\n" +
+		"server-port = 25565\n" +
+		"level-name: world\n" +
+		"level-seed=\n" +
+		"pvp\n" +
+		"generator-settings={\"a\"=1}\r\n" +
+		"\n" +
+		"   \n"
+	want := map[string]string{
+		"motd":               "A Minecraft Server",
+		"server-port":        "25565",
+		"level-name":         "world",
+		"level-seed":         "",
+		"pvp":                "",
+		"generator-settings": `{"a"=1}`,
+	}
+	if got := parseProperties(content); !maps.Equal(got, want) {
+		t.Errorf("parseProperties() = %q, want %q", got, want)
+	}
+	if got := parseProperties(""); len(got) != 0 {
+		t.Errorf("parseProperties(\"\") = %q, want empty", got)
+	}
+}
+
+func TestMergeProperties(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		overrides map[string]string
+		want      string
+	}{
+		{
+			name:      "empty file",
+			content:   "",
+			overrides: map[string]string{"pvp": "false", "motd": "Hi"},
+			want:      "motd=Hi\npvp=false\n",
+		},
+		{
+			name:      "replace in place",
+			content:   "#comment\nmotd=Old\npvp=true\nmax-players=20\n",
+			overrides: map[string]string{"pvp": "false"},
+			want:      "#comment\nmotd=Old\npvp=false\nmax-players=20\n",
+		},
+		{
+			name:      "append missing sorted",
+			content:   "motd=Old\n",
+			overrides: map[string]string{"view-distance": "8", "difficulty": "hard", "motd": "New"},
+			want:      "motd=New\ndifficulty=hard\nview-distance=8\n",
+		},
+		{
+			name:      "colon and spacing normalized",
+			content:   "server-port : 25565\nlevel-name=world",
+			overrides: map[string]string{"server-port": "25566"},
+			want:      "server-port=25566\nlevel-name=world\n",
+		},
+		{
+			name:      "no overrides keeps content",
+			content:   "motd=Old\n\n#end\n",
+			overrides: nil,
+			want:      "motd=Old\n\n#end\n",
+		},
+		{
+			name:      "duplicate keys all replaced",
+			content:   "pvp=true\npvp=true\n",
+			overrides: map[string]string{"pvp": "false"},
+			want:      "pvp=false\npvp=false\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeProperties(tt.content, tt.overrides)
+			if got != tt.want {
+				t.Errorf("mergeProperties() = %q, want %q", got, tt.want)
+			}
+			for k, v := range tt.overrides {
+				if parsed := parseProperties(got)[k]; parsed != v {
+					t.Errorf("merged %s = %q, want %q", k, parsed, v)
+				}
+			}
+		})
+	}
+}
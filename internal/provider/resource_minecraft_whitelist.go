@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &MinecraftWhitelistResource{}
+
+// MinecraftWhitelistResource manages players in a Minecraft whitelist.json.
+type MinecraftWhitelistResource struct {
+	resourceBase
+}
+
+// minecraftWhitelistModel holds the resource state.
+type minecraftWhitelistModel struct {
+	ServerID    types.String `tfsdk:"server_id"`
+	Players     types.Set    `tfsdk:"players"`      // player names managed by Terraform
+	OfflineMode types.Bool   `tfsdk:"offline_mode"` // derive UUIDs instead of asking Mojang
+	PlayerUUIDs types.Map    `tfsdk:"player_uuids"` // name -> UUID as written to the file
+	ID          types.String `tfsdk:"id"`           // synthetic: "<server_id>-whitelist"
+}
+
+func NewMinecraftWhitelistResource() resource.Resource {
+	return &MinecraftWhitelistResource{resourceBase: newResourceBase("kineticpanel_minecraft_whitelist", scopeClient)}
+}
+
+func (r *MinecraftWhitelistResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_minecraft_whitelist"
+}
+
+func (r *MinecraftWhitelistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importComposite(ctx, req, resp, serverIDPart)
+}
+
+func (r *MinecraftWhitelistResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages players in the `whitelist.json` of a Minecraft server through the files API (Client API). " +
+			"Entries for players not listed in `players` are kept. Destroying the resource removes only the listed players. " +
+			"Run `whitelist reload` (e.g. with `kineticpanel_server_command`) or restart the server to apply changes.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"players": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Player names to whitelist. Names are matched case-insensitively.",
+			},
+			"offline_mode": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Derive offline-mode UUIDs instead of looking names up at Mojang. Use for servers with `online-mode=false`. Changing it resolves the UUIDs of all managed players again. Default: false.",
+			},
+			"player_uuids": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "UUID of each managed player, keyed by name.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-whitelist`).",
+			},
+		},
+	}
+}
+
+func (r *MinecraftWhitelistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan minecraftWhitelistModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-whitelist")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *MinecraftWhitelistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state minecraftWhitelistModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := loadPlayerList(r.client, state.ServerID.ValueString(), "whitelist.json")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read whitelist.json", err.Error())
+		return
+	}
+
+	// After import nothing is managed yet, so adopt the whole file.
+	var managed []string
+	if state.Players.IsNull() {
+		for _, e := range entries {
+			managed = append(managed, e.name())
+		}
+	} else {
+		resp.Diagnostics.Append(state.Players.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	players, uuids, diags := observedPlayers(entries, managed)
+	resp.Diagnostics.Append(diags...)
+	state.Players = players
+	state.PlayerUUIDs = uuids
+	state.ID = types.StringValue(state.ServerID.ValueString() + "-whitelist")
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *MinecraftWhitelistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state minecraftWhitelistModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous []string
+	resp.Diagnostics.Append(state.Players.ElementsAs(ctx, &previous, false)...)
+	modeChanged := plan.OfflineMode.ValueBool() != state.OfflineMode.ValueBool()
+	resp.Diagnostics.Append(r.apply(ctx, &plan, previous, modeChanged)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-whitelist")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *MinecraftWhitelistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state minecraftWhitelistModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var names []string
+	resp.Diagnostics.Append(state.Players.ElementsAs(ctx, &names, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := state.ServerID.ValueString()
+	entries, err := loadPlayerList(r.client, serverID, "whitelist.json")
+	if err == nil {
+		err = savePlayerList(r.client, serverID, "whitelist.json", mergePlayers(entries, names, nil))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update whitelist.json", err.Error())
+	}
+}
+
+// apply writes the planned players, dropping players that were removed from
+// the configuration, and records their UUIDs in plan. Offline and online
+// UUIDs differ, so when offline_mode changed every UUID is resolved anew.
+func (r *MinecraftWhitelistResource) apply(ctx context.Context, plan *minecraftWhitelistModel, previous []string, modeChanged bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var names []string
+	diags.Append(plan.Players.ElementsAs(ctx, &names, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	serverID := plan.ServerID.ValueString()
+	entries, err := loadPlayerList(r.client, serverID, "whitelist.json")
+	if err != nil {
+		diags.AddError("Failed to read whitelist.json", err.Error())
+		return diags
+	}
+
+	known := entries
+	if modeChanged {
+		known = nil
+	}
+	upsert := make([]playerEntry, 0, len(names))
+	for _, name := range names {
+		id, err := resolvePlayerUUID(r.client, known, name, plan.OfflineMode.ValueBool())
+		if err != nil {
+			diags.AddError("Player Lookup Failed", err.Error())
+			return diags
+		}
+		upsert = append(upsert, playerEntry{"uuid": id, "name": name})
+	}
+
+	merged := mergePlayers(entries, removedPlayers(previous, names), upsert)
+	if err := savePlayerList(r.client, serverID, "whitelist.json", merged); err != nil {
+		diags.AddError("Failed to update whitelist.json", err.Error())
+		return diags
+	}
+
+	_, uuids, d := observedPlayers(merged, names)
+	diags.Append(d...)
+	plan.PlayerUUIDs = uuids
+	return diags
+}
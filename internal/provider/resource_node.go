@@ -0,0 +1,376 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &NodeResource{}
+	_ resource.ResourceWithImportState = &NodeResource{}
+)
+
+// NodeResource manages a node as an administrator.
+type NodeResource struct {
+	resourceBase
+}
+
+// nodeModel holds the resource state. Unset optional fields take the panel's
+// default on create and are read back afterwards.
+type nodeModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	UUID               types.String `tfsdk:"uuid"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	LocationID         types.Int64  `tfsdk:"location_id"`
+	Public             types.Bool   `tfsdk:"public"`
+	FQDN               types.String `tfsdk:"fqdn"`
+	Scheme             types.String `tfsdk:"scheme"`
+	BehindProxy        types.Bool   `tfsdk:"behind_proxy"`
+	Memory             types.Int64  `tfsdk:"memory"`
+	MemoryOverallocate types.Int64  `tfsdk:"memory_overallocate"`
+	Disk               types.Int64  `tfsdk:"disk"`
+	DiskOverallocate   types.Int64  `tfsdk:"disk_overallocate"`
+	UploadSize         types.Int64  `tfsdk:"upload_size"`
+	DaemonListen       types.Int64  `tfsdk:"daemon_listen"`
+	DaemonSFTP         types.Int64  `tfsdk:"daemon_sftp"`
+	DaemonBase         types.String `tfsdk:"daemon_base"`
+	MaintenanceMode    types.Bool   `tfsdk:"maintenance_mode"`
+}
+
+// Defaults the panel's node form uses; the API requires most of them.
+const (
+	defaultNodeScheme       = "https"
+	defaultNodeUploadSize   = 100
+	defaultNodeDaemonListen = 8080
+	defaultNodeDaemonSFTP   = 2022
+	defaultNodeDaemonBase   = "/var/lib/pterodactyl/volumes"
+)
+
+func NewNodeResource() resource.Resource {
+	return &NodeResource{resourceBase: newResourceBase("kineticpanel_node", scopeApplication)}
+}
+
+func (r *NodeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node"
+}
+
+func (r *NodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected the numeric node ID, got %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// nodeInt64 is an optional node setting that is read back when unset.
+func nodeInt64(description string, validators ...validator.Int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		Validators:  validators,
+		Description: description,
+	}
+}
+
+// nodeBool is an optional node flag that is read back when unset.
+func nodeBool(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+		Description: description,
+	}
+}
+
+func (r *NodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a node (Application API): its connection, resource totals, overallocation and daemon settings. " +
+			"Optional settings that are not configured get the panel's default on create and are read back afterwards, so changes in the panel UI show up as drift only for configured settings. " +
+			"Destroying the resource deletes the node, which the panel refuses while servers are on it. Use `kineticpanel_node_allocations` for its allocations.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Node ID.",
+			},
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+				Description: "Node name.",
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Node description. Default: empty.",
+			},
+			"location_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Location the node belongs to.",
+			},
+			"public": nodeBool("Whether automatic deployment may place servers on the node. Default: true."),
+			"fqdn": schema.StringAttribute{
+				Required:    true,
+				Description: "Domain name (or IP address when `scheme` is `http`) the panel and browsers use to reach the daemon.",
+			},
+			"scheme": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("http", "https"),
+				},
+				Description: "Whether the daemon is reached over `https` or `http`. Default: `https`.",
+			},
+			"behind_proxy": nodeBool("Whether the daemon runs behind a proxy such as Cloudflare that terminates TLS, so it does not look for its own certificates. Default: false."),
+			"memory": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Memory available to servers, in MiB.",
+			},
+			"memory_overallocate": nodeInt64("Percentage of `memory` servers may be allocated beyond it; -1 disables the check. Default: 0.",
+				int64validator.AtLeast(-1)),
+			"disk": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Disk space available to servers, in MiB.",
+			},
+			"disk_overallocate": nodeInt64("Percentage of `disk` servers may be allocated beyond it; -1 disables the check. Default: 0.",
+				int64validator.AtLeast(-1)),
+			"upload_size": nodeInt64(fmt.Sprintf("Largest file upload through the panel, in MiB. Default: %d.", defaultNodeUploadSize),
+				int64validator.Between(1, 1024)),
+			"daemon_listen": nodeInt64(fmt.Sprintf("Port the daemon's API listens on. Default: %d.", defaultNodeDaemonListen),
+				portValidator{}),
+			"daemon_sftp": nodeInt64(fmt.Sprintf("Port the daemon's SFTP server listens on. Default: %d.", defaultNodeDaemonSFTP),
+				portValidator{}),
+			"daemon_base": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: fmt.Sprintf("Directory on the node that holds server data. Default: `%s`.", defaultNodeDaemonBase),
+			},
+			"maintenance_mode": nodeBool("Whether the node is in maintenance mode, which blocks access to its servers. Default: false."),
+		},
+	}
+}
+
+// payload builds the node request body. The endpoints need every field, so
+// unset ones are sent as current (from current) or, on create, as defaults.
+func (m nodeModel) payload(current *nodeAttributes) map[string]any {
+	n := nodeAttributes{
+		Public:       true,
+		Scheme:       defaultNodeScheme,
+		UploadSize:   defaultNodeUploadSize,
+		DaemonListen: defaultNodeDaemonListen,
+		DaemonSFTP:   defaultNodeDaemonSFTP,
+		DaemonBase:   defaultNodeDaemonBase,
+	}
+	if current != nil {
+		n = *current
+	}
+	setString := func(v types.String, field *string) {
+		if !v.IsNull() && !v.IsUnknown() {
+			*field = v.ValueString()
+		}
+	}
+	setInt64 := func(v types.Int64, field *int64) {
+		if !v.IsNull() && !v.IsUnknown() {
+			*field = v.ValueInt64()
+		}
+	}
+	setBool := func(v types.Bool, field *bool) {
+		if !v.IsNull() && !v.IsUnknown() {
+			*field = v.ValueBool()
+		}
+	}
+	setString(m.Name, &n.Name)
+	setString(m.Description, &n.Description)
+	setInt64(m.LocationID, &n.LocationID)
+	setBool(m.Public, &n.Public)
+	setString(m.FQDN, &n.FQDN)
+	setString(m.Scheme, &n.Scheme)
+	setBool(m.BehindProxy, &n.BehindProxy)
+	setInt64(m.Memory, &n.Memory)
+	setInt64(m.MemoryOverallocate, &n.MemoryOverallocate)
+	setInt64(m.Disk, &n.Disk)
+	setInt64(m.DiskOverallocate, &n.DiskOverallocate)
+	setInt64(m.UploadSize, &n.UploadSize)
+	setInt64(m.DaemonListen, &n.DaemonListen)
+	setInt64(m.DaemonSFTP, &n.DaemonSFTP)
+	setString(m.DaemonBase, &n.DaemonBase)
+	setBool(m.MaintenanceMode, &n.MaintenanceMode)
+
+	return map[string]any{
+		"name":                n.Name,
+		"description":         n.Description,
+		"location_id":         n.LocationID,
+		"public":              n.Public,
+		"fqdn":                n.FQDN,
+		"scheme":              n.Scheme,
+		"behind_proxy":        n.BehindProxy,
+		"memory":              n.Memory,
+		"memory_overallocate": n.MemoryOverallocate,
+		"disk":                n.Disk,
+		"disk_overallocate":   n.DiskOverallocate,
+		"upload_size":         n.UploadSize,
+		"daemon_listen":       n.DaemonListen,
+		"daemon_sftp":         n.DaemonSFTP,
+		"daemon_base":         n.DaemonBase,
+		"maintenance_mode":    n.MaintenanceMode,
+	}
+}
+
+// nodeToModel copies a node into state.
+func nodeToModel(n nodeAttributes) nodeModel {
+	return nodeModel{
+		ID:                 types.Int64Value(n.ID),
+		UUID:               types.StringValue(n.UUID),
+		Name:               types.StringValue(n.Name),
+		Description:        types.StringValue(n.Description),
+		LocationID:         types.Int64Value(n.LocationID),
+		Public:             types.BoolValue(n.Public),
+		FQDN:               types.StringValue(n.FQDN),
+		Scheme:             types.StringValue(n.Scheme),
+		BehindProxy:        types.BoolValue(n.BehindProxy),
+		Memory:             types.Int64Value(n.Memory),
+		MemoryOverallocate: types.Int64Value(n.MemoryOverallocate),
+		Disk:               types.Int64Value(n.Disk),
+		DiskOverallocate:   types.Int64Value(n.DiskOverallocate),
+		UploadSize:         types.Int64Value(n.UploadSize),
+		DaemonListen:       types.Int64Value(n.DaemonListen),
+		DaemonSFTP:         types.Int64Value(n.DaemonSFTP),
+		DaemonBase:         types.StringValue(n.DaemonBase),
+		MaintenanceMode:    types.BoolValue(n.MaintenanceMode),
+	}
+}
+
+// parseNode decodes a node response.
+func parseNode(body []byte) (nodeAttributes, error) {
+	var apiResp struct {
+		Attributes nodeAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nodeAttributes{}, fmt.Errorf("JSON parse error: %w", err)
+	}
+	return apiResp.Attributes, nil
+}
+
+func (r *NodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan nodeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.client.Post("/nodes", plan.payload(nil))
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create node %s: %v", plan.Name.ValueString(), err))
+		return
+	}
+	node, err := parseNode(body)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create node %s: %v", plan.Name.ValueString(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, nodeToModel(node))...)
+}
+
+func (r *NodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state nodeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node, err := getNode(r.client, state.ID.ValueInt64())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch node %d: %v", state.ID.ValueInt64(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, nodeToModel(node))...)
+}
+
+func (r *NodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state nodeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeID := state.ID.ValueInt64()
+	current, err := getNode(r.client, nodeID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch node %d: %v", nodeID, err))
+		return
+	}
+	body, err := r.client.Patch("/nodes/"+strconv.FormatInt(nodeID, 10), plan.payload(&current))
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update node %d: %v", nodeID, err))
+		return
+	}
+	node, err := parseNode(body)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update node %d: %v", nodeID, err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, nodeToModel(node))...)
+}
+
+func (r *NodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state nodeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeID := state.ID.ValueInt64()
+	if err := r.client.Delete("/nodes/" + strconv.FormatInt(nodeID, 10)); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete node %d (it may still have servers): %v", nodeID, err))
+	}
+}
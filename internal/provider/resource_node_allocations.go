@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &NodeAllocationsResource{}
+
+// NodeAllocationsResource creates a set of allocations (IP + ports) on a node.
+type NodeAllocationsResource struct {
+	resourceBase
+}
+
+// nodeAllocationsModel holds the resource state.
+type nodeAllocationsModel struct {
+	NodeID      types.Int64  `tfsdk:"node_id"`
+	IP          types.String `tfsdk:"ip"`
+	Alias       types.String `tfsdk:"alias"`
+	Ports       types.List   `tfsdk:"ports"` // "25565" or "25565-25575"
+	Allocations types.List   `tfsdk:"allocations"`
+	ID          types.String `tfsdk:"id"` // synthetic: "<node_id>-<ip>"
+}
+
+var nodeAllocationAttrTypes = map[string]attr.Type{
+	"id":       types.Int64Type,
+	"port":     types.Int64Type,
+	"assigned": types.BoolType,
+}
+
+func NewNodeAllocationsResource() resource.Resource {
+	return &NodeAllocationsResource{resourceBase: newResourceBase("kineticpanel_node_allocations", scopeApplication)}
+}
+
+func (r *NodeAllocationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_allocations"
+}
+
+func (r *NodeAllocationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	nodeID, ip, ok := strings.Cut(req.ID, ":")
+	id, err := strconv.ParseInt(nodeID, 10, 64)
+	if !ok || err != nil || ip == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", "Expected format: <node_id>:<ip>")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip"), ip)...)
+}
+
+func (r *NodeAllocationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates allocations (an IP with ports or port ranges) on a node (Application API), so a new node can take deployments in the same apply. " +
+			"Ports that already exist are left alone. Removing ports, or destroying the resource, deletes their allocations unless they are assigned to a server.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Node to add the allocations to.",
+			},
+			"ip": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "IP address (or CIDR of up to /25) the ports listen on.",
+			},
+			"alias": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Alias shown to users instead of the IP (e.g. a hostname).",
+			},
+			"ports": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(portRangeValidator{min: minAllocationPort}),
+					portRangesOverlapValidator{},
+				},
+				Description: "Ports or port ranges, e.g. `[\"25565\", \"25566-25600\"]`. Ports must be within 1024-65535 and a range may hold at most 1000 ports.",
+			},
+			"allocations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The allocations on `ip` covered by `ports`, ordered by port.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":       schema.Int64Attribute{Computed: true},
+						"port":     schema.Int64Attribute{Computed: true},
+						"assigned": schema.BoolAttribute{Computed: true},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<node_id>-<ip>`).",
+			},
+		},
+	}
+}
+
+// ports returns the configured port entries and their expansion.
+func (m nodeAllocationsModel) ports(ctx context.Context) ([]string, []int64, diag.Diagnostics) {
+	var entries []string
+	diags := m.Ports.ElementsAs(ctx, &entries, false)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+	expanded, err := expandPorts(entries)
+	if err != nil {
+		diags.AddAttributeError(path.Root("ports"), "Invalid Ports", err.Error())
+	}
+	return entries, expanded, diags
+}
+
+// observe lists the node's allocations on ip whose port is in want (or all of
+// them when want is nil) and stores them in m.
+func (r *NodeAllocationsResource) observe(m *nodeAllocationsModel, want []int64) ([]nodeAllocation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	all, err := listNodeAllocations(r.client, m.NodeID.ValueInt64())
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to list allocations for node %d: %v", m.NodeID.ValueInt64(), err))
+		return nil, diags
+	}
+	wanted := map[int64]bool{}
+	for _, p := range want {
+		wanted[p] = true
+	}
+
+	var found []nodeAllocation
+	for _, a := range all {
+		if a.IP == m.IP.ValueString() && (want == nil || wanted[a.Port]) {
+			found = append(found, a)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].Port < found[j].Port })
+
+	items := make([]attr.Value, 0, len(found))
+	for _, a := range found {
+		obj, d := types.ObjectValue(nodeAllocationAttrTypes, map[string]attr.Value{
+			"id":       types.Int64Value(a.ID),
+			"port":     types.Int64Value(a.Port),
+			"assigned": types.BoolValue(a.Assigned),
+		})
+		diags.Append(d...)
+		items = append(items, obj)
+	}
+	list, d := types.ListValue(types.ObjectType{AttrTypes: nodeAllocationAttrTypes}, items)
+	diags.Append(d...)
+	m.Allocations = list
+	m.ID = types.StringValue(strconv.FormatInt(m.NodeID.ValueInt64(), 10) + "-" + m.IP.ValueString())
+	return found, diags
+}
+
+func (r *NodeAllocationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan nodeAllocationsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, expanded, diags := plan.ports(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := createNodeAllocations(r.client, plan.NodeID.ValueInt64(), plan.IP.ValueString(), plan.Alias.ValueString(), entries); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create allocations on node %d: %v", plan.NodeID.ValueInt64(), err))
+		return
+	}
+
+	_, diags = r.observe(&plan, expanded)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *NodeAllocationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state nodeAllocationsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// After import, adopt every allocation on the IP.
+	var expanded []int64
+	if !state.Ports.IsNull() {
+		var diags diag.Diagnostics
+		_, expanded, diags = state.ports(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	found, diags := r.observe(&state, expanded)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(found) == 0 && !state.Ports.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Missing ports show up as drift: list the ports that still exist.
+	if state.Ports.IsNull() || len(found) != len(expanded) {
+		ports := make([]string, 0, len(found))
+		for _, a := range found {
+			ports = append(ports, strconv.FormatInt(a.Port, 10))
+		}
+		list, diags := stringListValue(ports)
+		resp.Diagnostics.Append(diags...)
+		state.Ports = list
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *NodeAllocationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state nodeAllocationsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, expanded, diags := plan.ports(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeID := plan.NodeID.ValueInt64()
+	if err := createNodeAllocations(r.client, nodeID, plan.IP.ValueString(), plan.Alias.ValueString(), entries); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create allocations on node %d: %v", nodeID, err))
+		return
+	}
+
+	// Delete the allocations of ports that were dropped from the config.
+	keep := map[int64]bool{}
+	for _, p := range expanded {
+		keep[p] = true
+	}
+	_, previous, diags := state.ports(ctx)
+	resp.Diagnostics.Append(diags...)
+	var dropped []int64
+	for _, p := range previous {
+		if !keep[p] {
+			dropped = append(dropped, p)
+		}
+	}
+	if len(dropped) > 0 {
+		current, diags := r.observe(&state, dropped)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(r.deleteAllocations(nodeID, current)...)
+	}
+
+	_, diags = r.observe(&plan, expanded)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *NodeAllocationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state nodeAllocationsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, expanded, diags := state.ports(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	current, diags := r.observe(&state, expanded)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.deleteAllocations(state.NodeID.ValueInt64(), current)...)
+}
+
+// deleteAllocations deletes unassigned allocations and warns about assigned
+// ones, which the panel would refuse to delete.
+func (r *NodeAllocationsResource) deleteAllocations(nodeID int64, allocations []nodeAllocation) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var kept []string
+	for _, a := range allocations {
+		if a.Assigned {
+			kept = append(kept, strconv.FormatInt(a.Port, 10))
+			continue
+		}
+		if err := deleteNodeAllocation(r.client, nodeID, a.ID); err != nil && !isNotFound(err) {
+			diags.AddError("API Error", fmt.Sprintf("Failed to delete allocation %s:%d on node %d: %v", a.IP, a.Port, nodeID, err))
+		}
+	}
+	if len(kept) > 0 {
+		diags.AddWarning("Assigned Allocations Kept",
+			fmt.Sprintf("Ports %s on node %d are assigned to servers and were not deleted.", strings.Join(kept, ", "), nodeID))
+	}
+	return diags
+}
@@ -5,67 +5,138 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ resource.Resource = &ServerResource{}
+var (
+	_ resource.Resource                     = &ServerResource{}
+	_ resource.ResourceWithModifyPlan       = &ServerResource{}
+	_ resource.ResourceWithConfigValidators = &ServerResource{}
+)
 
 type serverAPIResponse struct {
 	Object     string `json:"object"`
 	Attributes struct {
-		ID          int64  `json:"id"`
-		Name        string `json:"name"`
-		User        int64  `json:"user"`
-		Egg         int64  `json:"egg"`
-		Location    int64  `json:"location"`
-		Node        int64  `json:"node"`
-		Memory      int64  `json:"memory"`
-		Disk        int64  `json:"disk"`
-		CPU         int64  `json:"cpu"`
-		DockerImage string `json:"docker_image"`
-		Startup     string `json:"startup"`
+		ID          int64   `json:"id"`
+		UUID        string  `json:"uuid"`
+		Identifier  string  `json:"identifier"`
+		Name        string  `json:"name"`
+		User        int64   `json:"user"`
+		Egg         int64   `json:"egg"`
+		Location    int64   `json:"location"`
+		Node        int64   `json:"node"`
+		Memory      int64   `json:"memory"`
+		Disk        int64   `json:"disk"`
+		CPU         int64   `json:"cpu"`
+		DockerImage string  `json:"docker_image"`
+		Startup     string  `json:"startup"`
+		Status      *string `json:"status"`     // null once installed, "installing", "install_failed", ...
+		Allocation  int64   `json:"allocation"` // primary allocation
+		// Only present when fetched through serverPath.
+		Relationships struct {
+			Allocations struct {
+				Data []struct {
+					Attributes nodeAllocation `json:"attributes"`
+				} `json:"data"`
+			} `json:"allocations"`
+			Egg struct {
+				Attributes struct {
+					Features []string `json:"features"`
+				} `json:"attributes"`
+			} `json:"egg"`
+		} `json:"relationships"`
 	} `json:"attributes"`
 }
 
 type ServerResource struct {
-	client *Client
+	resourceBase
 }
 
 type serverModel struct {
 	ID          types.Int64  `tfsdk:"id"`
+	UUID        types.String `tfsdk:"uuid"`
+	Identifier  types.String `tfsdk:"identifier"`
 	Name        types.String `tfsdk:"name"`
 	UserID      types.Int64  `tfsdk:"user_id"`
 	EggID       types.Int64  `tfsdk:"egg_id"`
 	LocationID  types.Int64  `tfsdk:"location_id"`
 	NodeID      types.Int64  `tfsdk:"node_id"`
+	AllocID     types.Int64  `tfsdk:"allocation_id"`
+	Deploy      types.Object `tfsdk:"deploy"` // only used on create
 	Memory      types.Int64  `tfsdk:"memory"`
 	Disk        types.Int64  `tfsdk:"disk"`
 	CPU         types.Int64  `tfsdk:"cpu"`
 	DockerImage types.String `tfsdk:"docker_image"`
 	StartupCmd  types.String `tfsdk:"startup_command"`
+	// Install tracking
+	Status         types.String `tfsdk:"status"`
+	IsInstalling   types.Bool   `tfsdk:"is_installing"`
+	WaitForInstall types.Bool   `tfsdk:"wait_for_install"`
+	InstallTimeout types.Int64  `tfsdk:"install_timeout_seconds"`
+	// Client API steps: restart after build changes, graceful destroy
+	ClientAPIKey         types.String `tfsdk:"client_api_key"`
+	RestartOnBuildChange types.Bool   `tfsdk:"restart_on_build_change"`
+	BeforeDestroy        types.Object `tfsdk:"before_destroy"`
+	// Derived from the primary allocation
+	Address          types.String `tfsdk:"address"`
+	ConnectionString types.String `tfsdk:"connection_string"`
 }
 
-func NewServerResource() resource.Resource { return &ServerResource{} }
+// serverDeployModel is the decoded `deploy` block.
+type serverDeployModel struct {
+	LocationIDs types.List `tfsdk:"location_ids"`
+	DedicatedIP types.Bool `tfsdk:"dedicated_ip"`
+	PortRange   types.List `tfsdk:"port_range"`
+}
+
+// serverPath is the Application API path of a server, including what the
+// address outputs are derived from.
+func serverPath(id int64) string {
+	return "/servers/" + strconv.FormatInt(id, 10) + "?include=allocations,egg"
+}
+
+var serverDeployAttrTypes = map[string]attr.Type{
+	"location_ids": types.ListType{ElemType: types.Int64Type},
+	"dedicated_ip": types.BoolType,
+	"port_range":   types.ListType{ElemType: types.StringType},
+}
+
+func NewServerResource() resource.Resource {
+	return &ServerResource{resourceBase: newResourceBase("kineticpanel_server", scopeApplication)}
+}
 
 func (r *ServerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_server"
 }
 
 func (r *ServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected the numeric server ID, got %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *ServerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a game server on Kinetic Panel using the Application API.",
+		Description: "Manages a game server on Kinetic Panel using the Application API. " +
+			"Place it with `node_id` and `allocation_id`, or let the panel pick with `deploy`; exactly one of the two must be set.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Computed: true,
@@ -73,64 +144,345 @@ func (r *ServerResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
-			"name":            schema.StringAttribute{Required: true},
-			"user_id":         schema.Int64Attribute{Required: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()}},
-			"egg_id":          schema.Int64Attribute{Required: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()}},
-			"location_id":     schema.Int64Attribute{Required: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()}},
-			"node_id":         schema.Int64Attribute{Required: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()}},
-			"memory":          schema.Int64Attribute{Required: true},
-			"disk":            schema.Int64Attribute{Required: true},
-			"cpu":             schema.Int64Attribute{Required: true},
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Long UUID of the server.",
+			},
+			"identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Short identifier (e.g. `abc123`) that Client API resources such as `kineticpanel_server_power` and `kineticpanel_server_file` take as `server_id`.",
+			},
+			"name":        schema.StringAttribute{Required: true},
+			"user_id":     schema.Int64Attribute{Required: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()}},
+			"egg_id":      schema.Int64Attribute{Required: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()}},
+			"location_id": schema.Int64Attribute{Required: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()}},
+			"node_id": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Node to create the server on, together with `allocation_id`. Computed when `deploy` is used.",
+			},
+			"allocation_id": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Primary allocation on `node_id`. Computed when `deploy` is used.",
+			},
+			"deploy": schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Let the panel pick a node and a free allocation instead of setting `node_id` and `allocation_id`. " +
+					"Only used when the server is created; changing it later has no effect.",
+				Attributes: map[string]schema.Attribute{
+					"location_ids": schema.ListAttribute{
+						ElementType: types.Int64Type,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						Description: "Locations to pick a node from. Default: `[location_id]`.",
+					},
+					"dedicated_ip": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Only pick an IP no other server uses. Default: false.",
+					},
+					"port_range": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(portRangeValidator{min: minAllocationPort}),
+							portRangesOverlapValidator{},
+						},
+						Description: "Ports or ranges to pick the allocation from, e.g. `[\"25565\", \"25600-25700\"]`. Ports must be within 1024-65535 and ranges must not overlap. Default: any port.",
+					},
+				},
+			},
+			"memory": schema.Int64Attribute{
+				Required:    true,
+				Validators:  []validator.Int64{memoryLimitValidator()},
+				Description: fmt.Sprintf("Memory limit in MiB: 0 for unlimited or at least %d.", minMemoryMiB),
+			},
+			"disk": schema.Int64Attribute{
+				Required:    true,
+				Validators:  []validator.Int64{diskLimitValidator()},
+				Description: fmt.Sprintf("Disk limit in MiB: 0 for unlimited or at least %d.", minDiskMiB),
+			},
+			"cpu": schema.Int64Attribute{
+				Required:    true,
+				Validators:  []validator.Int64{cpuLimitValidator()},
+				Description: fmt.Sprintf("CPU limit in percent of one core (200 is two cores): 0 for unlimited or at least %d.", minCPUPercent),
+			},
 			"docker_image":    schema.StringAttribute{Required: true},
 			"startup_command": schema.StringAttribute{Required: true},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Panel status of the server: null once installed, otherwise e.g. `installing`, `install_failed`, `reinstall_failed`, `suspended` or `restoring_backup`.",
+			},
+			"is_installing": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the install script is still running.",
+			},
+			"wait_for_install": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Block create until the install script finishes and fail the apply if it fails. Default: false.",
+			},
+			"install_timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the install when `wait_for_install` is set. Default: 900.",
+			},
+			"client_api_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Client API key of a user with power and backup access to the server, used by `restart_on_build_change` and `before_destroy`.",
+			},
+			"restart_on_build_change": schema.BoolAttribute{
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("client_api_key")),
+				},
+				Description: fmt.Sprintf("Restart a running server after `memory`, `disk` or `cpu` change, so the container gets the new limits, and wait up to %d seconds for it to run again. "+
+					"Offline servers get them on their next start. Needs `client_api_key`. Default: false.", int64(buildRestartTimeout/time.Second)),
+			},
+			"before_destroy": serverBeforeDestroyAttribute(),
+			"address": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "`host:port` of the primary allocation, using its alias when set.",
+			},
+			"connection_string": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "What players enter to join, based on the egg's features when the panel reports them: the address without the default port for Minecraft, a `steam://connect/` link for Steam games, otherwise `address`.",
+			},
 		},
 	}
 }
 
-func (r *ServerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+func (r *ServerResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{serverPlacementValidator{}}
+}
+
+// serverPlacementValidator requires exactly one of `node_id` with
+// `allocation_id`, or `deploy`, so the panel never gets both or neither.
+type serverPlacementValidator struct{}
+
+func (v serverPlacementValidator) Description(_ context.Context) string {
+	return "Exactly one of node_id with allocation_id, or deploy, must be set."
+}
+
+func (v serverPlacementValidator) MarkdownDescription(_ context.Context) string {
+	return "Exactly one of `node_id` with `allocation_id`, or `deploy`, must be set."
+}
+
+func (v serverPlacementValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var nodeID, allocID types.Int64
+	var deploy types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("node_id"), &nodeID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("allocation_id"), &allocID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deploy"), &deploy)...)
+	if resp.Diagnostics.HasError() || nodeID.IsUnknown() || allocID.IsUnknown() || deploy.IsUnknown() {
+		return
+	}
+
+	switch {
+	case !deploy.IsNull() && (!nodeID.IsNull() || !allocID.IsNull()):
+		resp.Diagnostics.AddAttributeError(path.Root("deploy"), "Conflicting Server Placement",
+			"`deploy` lets the panel pick the node and allocation, so it cannot be combined with `node_id` or `allocation_id`. Remove one or the other.")
+	case !deploy.IsNull():
+	case nodeID.IsNull() && allocID.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("node_id"), "Missing Server Placement",
+			"Set `node_id` and `allocation_id` to place the server yourself, or `deploy` to let the panel pick.")
+	case allocID.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("allocation_id"), "Missing Allocation",
+			"`allocation_id` is required with `node_id`: the server needs a primary allocation on that node.")
+	case nodeID.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("node_id"), "Missing Node",
+			"`node_id` is required with `allocation_id`.")
+	}
+}
+
+// ModifyPlan warns when docker_image is not in the egg's allowed list, or with
+// verify_docker_images not in its registry, which otherwise only shows up as
+// a failed boot.
+func (r *ServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*Client)
-	if !ok {
-		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+	var plan serverModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.EggID.IsUnknown() || plan.DockerImage.IsUnknown() {
 		return
 	}
-	r.client = client
+	if !req.State.Raw.IsNull() {
+		var state serverModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if state.EggID.Equal(plan.EggID) && state.DockerImage.Equal(plan.DockerImage) {
+			return
+		}
+	}
+
+	warnMissingImage(ctx, &resp.Diagnostics, r.client, path.Root("docker_image"), plan.DockerImage.ValueString())
+	egg, err := findEgg(r.client, plan.EggID.ValueInt64())
+	if err != nil {
+		tflog.Debug(ctx, "Skipping docker image check", map[string]any{"error": err.Error()})
+		return
+	}
+	warnUnlistedImage(&resp.Diagnostics, path.Root("docker_image"), plan.DockerImage.ValueString(), egg.allowedImages(), fmt.Sprintf("Egg %d (%s)", egg.ID, egg.Name))
 }
 
 func modelToPayload(plan serverModel) map[string]any {
-	return map[string]any{
+	payload := map[string]any{
 		"name":         plan.Name.ValueString(),
 		"user":         plan.UserID.ValueInt64(),
 		"egg":          plan.EggID.ValueInt64(),
 		"location":     plan.LocationID.ValueInt64(),
-		"node":         plan.NodeID.ValueInt64(),
 		"memory":       plan.Memory.ValueInt64(),
 		"disk":         plan.Disk.ValueInt64(),
 		"cpu":          plan.CPU.ValueInt64(),
 		"docker_image": plan.DockerImage.ValueString(),
 		"startup":      plan.StartupCmd.ValueString(),
 	}
+	if !plan.NodeID.IsNull() && !plan.NodeID.IsUnknown() {
+		payload["node"] = plan.NodeID.ValueInt64()
+	}
+	return payload
+}
+
+// createPayload is modelToPayload plus the placement, which is only sent on
+// create: the primary allocation on node_id, or the deploy block.
+func createPayload(ctx context.Context, plan serverModel) (map[string]any, diag.Diagnostics) {
+	payload := modelToPayload(plan)
+	if plan.Deploy.IsNull() || plan.Deploy.IsUnknown() {
+		payload["allocation"] = map[string]any{"default": plan.AllocID.ValueInt64()}
+		return payload, nil
+	}
+
+	var d serverDeployModel
+	diags := plan.Deploy.As(ctx, &d, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	locations := []int64{plan.LocationID.ValueInt64()}
+	if !d.LocationIDs.IsNull() {
+		diags.Append(d.LocationIDs.ElementsAs(ctx, &locations, false)...)
+	}
+	ports := []string{}
+	if !d.PortRange.IsNull() {
+		diags.Append(d.PortRange.ElementsAs(ctx, &ports, false)...)
+	}
+	payload["deploy"] = map[string]any{
+		"locations":    locations,
+		"dedicated_ip": d.DedicatedIP.ValueBool(),
+		"port_range":   ports,
+	}
+	return payload, diags
+}
+
+// serverSeenKey is the private state key of the hash of modelToPayload as
+// last written or read.
+const serverSeenKey = "payload_sha256"
+
+// keepConfig copies the config-only attributes, which the API does not
+// return, from another model of the same server.
+func (m *serverModel) keepConfig(from serverModel) {
+	m.Deploy = from.Deploy
+	m.WaitForInstall = from.WaitForInstall
+	m.InstallTimeout = from.InstallTimeout
+	m.ClientAPIKey = from.ClientAPIKey
+	m.RestartOnBuildChange = from.RestartOnBuildChange
+	m.BeforeDestroy = from.BeforeDestroy
 }
 
 func apiToModel(apiResp serverAPIResponse) serverModel {
 	a := apiResp.Attributes
+	status := ""
+	if a.Status != nil {
+		status = *a.Status
+	}
+	var address string
+	var port int64
+	for _, alloc := range a.Relationships.Allocations.Data {
+		if alloc.Attributes.ID == a.Allocation {
+			address = allocationAddress(alloc.Attributes.IP, alloc.Attributes.Alias, alloc.Attributes.Port)
+			port = alloc.Attributes.Port
+		}
+	}
 	return serverModel{
-		ID:          types.Int64Value(a.ID),
-		Name:        types.StringValue(a.Name),
-		UserID:      types.Int64Value(a.User),
-		EggID:       types.Int64Value(a.Egg),
-		LocationID:  types.Int64Value(a.Location),
-		NodeID:      types.Int64Value(a.Node),
-		Memory:      types.Int64Value(a.Memory),
-		Disk:        types.Int64Value(a.Disk),
-		CPU:         types.Int64Value(a.CPU),
-		DockerImage: types.StringValue(a.DockerImage),
-		StartupCmd:  types.StringValue(a.Startup),
+		ID:               types.Int64Value(a.ID),
+		UUID:             types.StringValue(a.UUID),
+		Identifier:       types.StringValue(a.Identifier),
+		Name:             types.StringValue(a.Name),
+		UserID:           types.Int64Value(a.User),
+		EggID:            types.Int64Value(a.Egg),
+		LocationID:       types.Int64Value(a.Location),
+		NodeID:           types.Int64Value(a.Node),
+		AllocID:          types.Int64Value(a.Allocation),
+		Deploy:           types.ObjectNull(serverDeployAttrTypes),
+		BeforeDestroy:    types.ObjectNull(serverBeforeDestroyAttrTypes),
+		Memory:           types.Int64Value(a.Memory),
+		Disk:             types.Int64Value(a.Disk),
+		CPU:              types.Int64Value(a.CPU),
+		DockerImage:      types.StringValue(a.DockerImage),
+		StartupCmd:       types.StringValue(a.Startup),
+		Status:           types.StringPointerValue(a.Status),
+		IsInstalling:     types.BoolValue(status == "installing"),
+		Address:          types.StringValue(address),
+		ConnectionString: types.StringValue(connectionString(address, port, a.Relationships.Egg.Attributes.Features)),
 	}
 }
 
+// waitForInstall polls the server until the install script is no longer
+// running. It returns the last response together with an error when the
+// install failed or did not finish within timeout.
+func waitForInstall(ctx context.Context, client *Client, id int64, timeout time.Duration) (serverAPIResponse, error) {
+	var apiResp serverAPIResponse
+	err := poll(ctx, pollOptions{
+		What:    "server install",
+		Fields:  map[string]any{"id": id},
+		Timeout: timeout,
+		TimeoutError: func(string) error {
+			return fmt.Errorf("server %d was still installing after %s", id, timeout)
+		},
+	}, func() (bool, string, error) {
+		body, err := client.Get(serverPath(id))
+		if err != nil {
+			return false, "", err
+		}
+		if err := json.Unmarshal(body, &apiResp); err != nil {
+			return false, "", err
+		}
+
+		status := ""
+		if apiResp.Attributes.Status != nil {
+			status = *apiResp.Attributes.Status
+		}
+		switch status {
+		case "installing":
+			return false, status, nil
+		case "install_failed", "reinstall_failed":
+			return false, status, fmt.Errorf("server %d finished installing with status %q. The panel does not expose the installer output over the API; check the server console for the install log", id, status)
+		default:
+			return true, status, nil
+		}
+	})
+	return apiResp, err
+}
+
 func (r *ServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan serverModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -138,8 +490,14 @@ func (r *ServerResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	payload, diags := createPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, "Creating server", map[string]any{"name": plan.Name.ValueString()})
-	body, err := r.client.Post("/servers", modelToPayload(plan))
+	body, err := r.client.Post("/servers", payload)
 	if err != nil {
 		resp.Diagnostics.AddError("API Create Error", err.Error())
 		return
@@ -151,8 +509,31 @@ func (r *ServerResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	if plan.WaitForInstall.ValueBool() {
+		timeout := 900 * time.Second
+		if !plan.InstallTimeout.IsNull() {
+			timeout = time.Duration(plan.InstallTimeout.ValueInt64()) * time.Second
+		}
+		final, err := waitForInstall(ctx, r.client, apiResp.Attributes.ID, timeout)
+		if err != nil {
+			// Keep the server in state so it is tainted rather than orphaned.
+			resp.Diagnostics.AddError("Server Install Failed", err.Error())
+		}
+		if final.Attributes.ID != 0 {
+			apiResp = final
+		}
+	} else if body, err := r.client.Get(serverPath(apiResp.Attributes.ID)); err == nil {
+		// The create response has no relationships to derive the address from.
+		var full serverAPIResponse
+		if json.Unmarshal(body, &full) == nil {
+			apiResp = full
+		}
+	}
+
 	state := apiToModel(apiResp)
+	state.keepConfig(plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setPrivateJSON(ctx, resp.Private, serverSeenKey, payloadHash(modelToPayload(state)))...)
 }
 
 func (r *ServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -162,9 +543,9 @@ func (r *ServerResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	body, err := r.client.Get("/servers/" + strconv.FormatInt(state.ID.ValueInt64(), 10))
+	body, err := r.client.Get(serverPath(state.ID.ValueInt64()))
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if isNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -178,8 +559,15 @@ func (r *ServerResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	prior, dockerImage := state, state.DockerImage
 	state = apiToModel(apiResp)
+	state.keepConfig(prior)
+	// Keep the configured spelling of the image, e.g. `java:17` for `docker.io/library/java:17`
+	if !dockerImage.IsNull() && sameImage(dockerImage.ValueString(), state.DockerImage.ValueString()) {
+		state.DockerImage = dockerImage
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setPrivateJSON(ctx, resp.Private, serverSeenKey, payloadHash(modelToPayload(state)))...)
 }
 
 func (r *ServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -189,17 +577,80 @@ func (r *ServerResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	var prior serverModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// When only config-only attributes changed, the panel already has the payload.
+	var seen string
+	if getPrivateJSON(ctx, req.Private, serverSeenKey, &seen) && seen == payloadHash(modelToPayload(plan)) {
+		state := prior
+		state.keepConfig(plan)
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+
 	_, err := r.client.Patch("/servers/"+strconv.FormatInt(plan.ID.ValueInt64(), 10), modelToPayload(plan))
 	if err != nil {
 		resp.Diagnostics.AddError("API Update Error", err.Error())
 		return
 	}
 
-	readReq := resource.ReadRequest{State: req.State}
-	var readResp resource.ReadResponse
-	r.Read(ctx, readReq, &readResp)
-	resp.Diagnostics.Append(readResp.Diagnostics...)
-	resp.State = readResp.State
+	body, err := r.client.Get(serverPath(plan.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("API Read Error", err.Error())
+		return
+	}
+
+	var apiResp serverAPIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		resp.Diagnostics.AddError("JSON Parse Error", err.Error())
+		return
+	}
+
+	// Config-only attributes are not returned by the API; keep the planned values.
+	state := apiToModel(apiResp)
+	state.keepConfig(plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setPrivateJSON(ctx, resp.Private, serverSeenKey, payloadHash(modelToPayload(state)))...)
+
+	buildChanged := !prior.Memory.Equal(state.Memory) || !prior.Disk.Equal(state.Disk) || !prior.CPU.Equal(state.CPU)
+	if buildChanged && plan.RestartOnBuildChange.ValueBool() {
+		cc := r.client.clientAPI(plan.ClientAPIKey.ValueString())
+		if err := restartForBuild(ctx, cc, state.Identifier.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Restart Failed",
+				fmt.Sprintf("The new limits of server %d are saved but not applied to the container: %v", state.ID.ValueInt64(), err))
+		}
+	}
+}
+
+// buildRestartTimeout is how long restart_on_build_change waits for the
+// server to run again.
+const buildRestartTimeout = 300 * time.Second
+
+// restartForBuild restarts a running server so its container picks up new
+// limits, and waits until it runs again. Other states are left alone.
+func restartForBuild(ctx context.Context, cc *Client, identifier string) error {
+	status, err := fetchServerStatus(cc, identifier)
+	if err != nil {
+		return fmt.Errorf("fetch state of server %s: %w", identifier, err)
+	}
+	if status.CurrentState != "running" {
+		tflog.Debug(ctx, "Not restarting server after build change", map[string]any{"server_id": identifier, "state": status.CurrentState})
+		return nil
+	}
+	if err := sendPower(cc, identifier, "restart"); err != nil {
+		return fmt.Errorf("restart server %s: %w", identifier, err)
+	}
+	// Give the daemon a moment to leave `running` before polling for it.
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(5 * time.Second):
+	}
+	return waitForClientState(ctx, cc, identifier, "running", buildRestartTimeout, 5*time.Second)
 }
 
 func (r *ServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -209,8 +660,14 @@ func (r *ServerResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if err := beforeDestroy(ctx, r.client, state); err != nil {
+		resp.Diagnostics.AddError("Graceful Destroy Failed",
+			fmt.Sprintf("Server %d was not deleted: %v. Fix the cause, or remove before_destroy to destroy the server without it.", state.ID.ValueInt64(), err))
+		return
+	}
+
 	err := r.client.Delete("/servers/" + strconv.FormatInt(state.ID.ValueInt64(), 10))
-	if err != nil && !strings.Contains(err.Error(), "404") {
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError("API Delete Error", err.Error())
 	}
 }
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ServerAdminReinstallResource{}
+
+// ServerAdminReinstallResource reinstalls a server through the Application API and waits for the result.
+type ServerAdminReinstallResource struct {
+	resourceBase
+}
+
+// adminReinstallModel holds the resource state.
+type adminReinstallModel struct {
+	ServerID       types.Int64  `tfsdk:"server_id"`
+	InstallTimeout types.Int64  `tfsdk:"install_timeout_seconds"`
+	Triggers       types.Map    `tfsdk:"triggers"` // reinstall again when changed
+	Status         types.String `tfsdk:"status"`   // server status after the install
+	ID             types.String `tfsdk:"id"`       // synthetic: "<server_id>-reinstall"
+}
+
+func NewServerAdminReinstallResource() resource.Resource {
+	return &ServerAdminReinstallResource{resourceBase: newResourceBase("kineticpanel_server_admin_reinstall", scopeApplication)}
+}
+
+func (r *ServerAdminReinstallResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_admin_reinstall"
+}
+
+func (r *ServerAdminReinstallResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reinstalls a Kinetic Panel server (Application API) and waits until the install script finishes; the apply fails if the install fails or times out. " +
+			"Unlike `kineticpanel_server_reinstall`, resources depending on this one only run after the server is installed again. " +
+			"The reinstall runs on create; changing `triggers` replaces the resource, which reinstalls again. Destroying the resource does nothing.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Numeric server ID.",
+			},
+			"install_timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Maximum time to wait for the install. Default: 900. Changing it does not reinstall.",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that re-fire the reinstall when changed (e.g. an egg version).",
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Server status after the install; empty when the server installed successfully.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-reinstall`).",
+			},
+		},
+	}
+}
+
+func (r *ServerAdminReinstallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan adminReinstallModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := plan.ServerID.ValueInt64()
+	if _, err := r.client.Post("/servers/"+strconv.FormatInt(serverID, 10)+"/reinstall", nil); err != nil {
+		resp.Diagnostics.AddError("Failed to trigger reinstall", err.Error())
+		return
+	}
+
+	timeout := 900 * time.Second
+	if !plan.InstallTimeout.IsNull() {
+		timeout = time.Duration(plan.InstallTimeout.ValueInt64()) * time.Second
+	}
+	tflog.Info(ctx, "Waiting for server reinstall", map[string]any{"id": serverID, "timeout": timeout.String()})
+	final, err := waitForInstall(ctx, r.client, serverID, timeout)
+	if err != nil {
+		// Nothing is saved, so the next apply reinstalls again.
+		resp.Diagnostics.AddError("Server Reinstall Failed", err.Error())
+		return
+	}
+
+	status := ""
+	if final.Attributes.Status != nil {
+		status = *final.Attributes.Status
+	}
+	plan.Status = types.StringValue(status)
+	plan.ID = types.StringValue(strconv.FormatInt(serverID, 10) + "-reinstall")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerAdminReinstallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state adminReinstallModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// No read-back — reinstall is a one-time action
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerAdminReinstallResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan adminReinstallModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only `install_timeout_seconds` can change in place, and it has no effect
+	// until the next reinstall; use `triggers` to reinstall again.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerAdminReinstallResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: reinstall cannot be undone
+	resp.State.RemoveResource(ctx)
+}
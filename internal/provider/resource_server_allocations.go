@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ServerAllocationsResource{}
+
+// ServerAllocationsResource assigns node allocations to a server as an administrator.
+type ServerAllocationsResource struct {
+	resourceBase
+}
+
+// serverAllocationsModel holds the resource state.
+type serverAllocationsModel struct {
+	ServerID            types.Int64  `tfsdk:"server_id"`
+	AllocationIDs       types.Set    `tfsdk:"allocation_ids"` // only the allocations managed by Terraform, unless exclusive
+	PrimaryAllocationID types.Int64  `tfsdk:"primary_allocation_id"`
+	Exclusive           types.Bool   `tfsdk:"exclusive"`
+	ID                  types.String `tfsdk:"id"` // synthetic: "<server_id>-allocations"
+}
+
+func NewServerAllocationsResource() resource.Resource {
+	return &ServerAllocationsResource{resourceBase: newResourceBase("kineticpanel_server_allocations", scopeApplication)}
+}
+
+func (r *ServerAllocationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_allocations"
+}
+
+func (r *ServerAllocationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", "Expected the numeric server ID.")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), id)...)
+}
+
+func (r *ServerAllocationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assigns specific node allocations to a server, and optionally strips every other extra allocation (Application API). " +
+			"Unlike Client API allocations, any free allocation of the server's node can be picked. " +
+			"Allocations removed from `allocation_ids`, or all of them on destroy, are unassigned; the primary allocation is never removed.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Numeric server ID.",
+			},
+			"allocation_ids": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Required:    true,
+				Description: "Allocations to assign. They must be free allocations on the server's node (see `kineticpanel_node_allocations`).",
+			},
+			"primary_allocation_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Make this allocation the server's primary allocation. It must be assigned, either already or through `allocation_ids`.",
+			},
+			"exclusive": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Unassign every other allocation except the primary one, so the server ends up with exactly `allocation_ids`. Default: false.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-allocations`).",
+			},
+		},
+	}
+}
+
+// allocationIDs returns the managed allocation IDs, or nil when unset.
+func (m serverAllocationsModel) allocationIDs(ctx context.Context) ([]int64, diag.Diagnostics) {
+	if m.AllocationIDs.IsNull() || m.AllocationIDs.IsUnknown() {
+		return nil, nil
+	}
+	var ids []int64
+	diags := m.AllocationIDs.ElementsAs(ctx, &ids, false)
+	return ids, diags
+}
+
+// apply assigns the planned allocations and unassigns those in previous that
+// are no longer planned (or every unplanned one when exclusive).
+func (r *ServerAllocationsResource) apply(ctx context.Context, plan serverAllocationsModel, previous []int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	wanted, d := plan.allocationIDs(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	serverID := plan.ServerID.ValueInt64()
+	build, err := getAppServerBuild(r.client, serverID)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to fetch build for server %d: %v", serverID, err))
+		return diags
+	}
+	current := build.allocationIDs()
+
+	primary := build.Allocation
+	if !plan.PrimaryAllocationID.IsNull() {
+		primary = plan.PrimaryAllocationID.ValueInt64()
+	}
+
+	var add, remove []int64
+	for _, id := range wanted {
+		if !slices.Contains(current, id) {
+			add = append(add, id)
+		}
+	}
+	candidates := previous
+	if plan.Exclusive.ValueBool() {
+		candidates = current
+	}
+	for _, id := range candidates {
+		if slices.Contains(wanted, id) || !slices.Contains(current, id) || slices.Contains(remove, id) {
+			continue
+		}
+		if id == primary {
+			diags.AddWarning("Primary Allocation Kept",
+				fmt.Sprintf("Allocation %d is the primary allocation of server %d and was not unassigned.", id, serverID))
+			continue
+		}
+		remove = append(remove, id)
+	}
+
+	if len(add) == 0 && len(remove) == 0 && primary == build.Allocation {
+		return diags
+	}
+	err = updateAppServerBuild(r.client, build, map[string]any{
+		"allocation":         primary,
+		"add_allocations":    add,
+		"remove_allocations": remove,
+	})
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to update allocations for server %d: %v", serverID, err))
+	}
+	return diags
+}
+
+func (r *ServerAllocationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverAllocationsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(plan.ServerID.ValueInt64(), 10) + "-allocations")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerAllocationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverAllocationsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := state.ServerID.ValueInt64()
+	build, err := getAppServerBuild(r.client, serverID)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch build for server %d: %v", serverID, err))
+		return
+	}
+	managed, diags := state.allocationIDs(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// After import, or when exclusive, every extra allocation counts.
+	adoptAll := managed == nil || state.Exclusive.ValueBool()
+	observed := []attr.Value{}
+	for _, id := range build.allocationIDs() {
+		if slices.Contains(managed, id) || (adoptAll && id != build.Allocation) {
+			observed = append(observed, types.Int64Value(id))
+		}
+	}
+	set, diags := types.SetValue(types.Int64Type, observed)
+	resp.Diagnostics.Append(diags...)
+	state.AllocationIDs = set
+	if !state.PrimaryAllocationID.IsNull() {
+		state.PrimaryAllocationID = types.Int64Value(build.Allocation)
+	}
+	state.ID = types.StringValue(strconv.FormatInt(serverID, 10) + "-allocations")
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerAllocationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state serverAllocationsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, diags := state.allocationIDs(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.apply(ctx, plan, previous)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(plan.ServerID.ValueInt64(), 10) + "-allocations")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerAllocationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverAllocationsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, diags := state.allocationIDs(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := getAppServerBuild(r.client, state.ServerID.ValueInt64()); isNotFound(err) {
+		return
+	}
+
+	// Unassign everything this resource managed; the primary allocation stays.
+	empty, diags := types.SetValue(types.Int64Type, []attr.Value{})
+	resp.Diagnostics.Append(diags...)
+	release := state
+	release.AllocationIDs = empty
+	release.PrimaryAllocationID = types.Int64Null()
+	release.Exclusive = types.BoolValue(false)
+	resp.Diagnostics.Append(r.apply(ctx, release, previous)...)
+}
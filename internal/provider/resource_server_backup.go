@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ServerBackupResource{}
+
+// ServerBackupResource creates a backup of a server.
+type ServerBackupResource struct {
+	resourceBase
+}
+
+// backupModel holds the resource state.
+type backupModel struct {
+	ServerID          types.String `tfsdk:"server_id"`
+	Name              types.String `tfsdk:"name"`
+	IgnoredFiles      types.List   `tfsdk:"ignored_files"`
+	IsLocked          types.Bool   `tfsdk:"is_locked"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	Bytes             types.Int64  `tfsdk:"bytes"`
+	Checksum          types.String `tfsdk:"checksum"`
+	IsSuccessful      types.Bool   `tfsdk:"is_successful"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	CompletedAt       types.String `tfsdk:"completed_at"`
+	ID                types.String `tfsdk:"id"` // backup UUID
+}
+
+func NewServerBackupResource() resource.Resource {
+	return &ServerBackupResource{resourceBase: newResourceBase("kineticpanel_server_backup", scopeClient)}
+}
+
+func (r *ServerBackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_backup"
+}
+
+func (r *ServerBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importComposite(ctx, req, resp, serverIDPart, uuidPart("id", "<backup_uuid>"))
+}
+
+func (r *ServerBackupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a backup of a Kinetic Panel server (Client API). Destroying the resource deletes the backup unless it is locked. " +
+			"Use `ignored_files` to leave caches and logs out of the archive, and `kineticpanel_server_backup_retention` to cap the number of backups.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Backup name. When omitted the panel picks one.",
+			},
+			"ignored_files": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Description: "Gitignore-style patterns to exclude from the backup (e.g. `logs/`, `cache/`, `*.log`). Changing them creates a new backup.",
+			},
+			"is_locked": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Lock the backup so it cannot be deleted, including by retention. Default: false.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Block create until the backup finishes and fail the apply if it fails. Default: false.",
+			},
+			"bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Archive size; 0 until the backup completes.",
+			},
+			"checksum": schema.StringAttribute{
+				Computed:    true,
+				Description: "Archive checksum once the backup completes.",
+			},
+			"is_successful": schema.BoolAttribute{Computed: true},
+			"created_at":    schema.StringAttribute{Computed: true},
+			"completed_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Completion time, or null while the backup is running.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Backup UUID.",
+			},
+		},
+	}
+}
+
+// fromAPI copies the API fields of b into m.
+func (m *backupModel) fromAPI(b backupAttributes) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = types.StringValue(b.UUID)
+	m.Name = types.StringValue(b.Name)
+	m.Bytes = types.Int64Value(b.Bytes)
+	m.Checksum = types.StringPointerValue(b.Checksum)
+	m.IsSuccessful = types.BoolValue(b.IsSuccessful)
+	m.CreatedAt = types.StringValue(b.CreatedAt)
+	m.CompletedAt = types.StringPointerValue(b.CompletedAt)
+	// Keep unset optional attributes null while they match the panel defaults.
+	if !m.IsLocked.IsNull() || b.IsLocked {
+		m.IsLocked = types.BoolValue(b.IsLocked)
+	}
+	if !m.IgnoredFiles.IsNull() || len(b.IgnoredFiles) > 0 {
+		list, d := stringListValue(b.IgnoredFiles)
+		diags.Append(d...)
+		m.IgnoredFiles = list
+	}
+	return diags
+}
+
+// waitForBackup polls a backup until it completes.
+func waitForBackup(ctx context.Context, client *Client, serverID, uuid string) (backupAttributes, error) {
+	var b backupAttributes
+	err := poll(ctx, pollOptions{
+		What:   "backup",
+		Fields: map[string]any{"server_id": serverID, "uuid": uuid},
+	}, func() (bool, string, error) {
+		var err error
+		if b, err = getBackup(client, serverID, uuid); err != nil {
+			return false, "", err
+		}
+		if b.CompletedAt == nil {
+			return false, "running", nil
+		}
+		if !b.IsSuccessful {
+			return false, "failed", fmt.Errorf("backup %s of server %s failed", uuid, serverID)
+		}
+		return true, "completed", nil
+	})
+	return b, err
+}
+
+func (r *ServerBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan backupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ignored []string
+	if !plan.IgnoredFiles.IsNull() {
+		resp.Diagnostics.Append(plan.IgnoredFiles.ElementsAs(ctx, &ignored, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	serverID := plan.ServerID.ValueString()
+	backup, err := createBackup(r.client, serverID, plan.Name.ValueString(), ignored, plan.IsLocked.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create backup for server %s: %v", serverID, err))
+		return
+	}
+
+	if plan.WaitForCompletion.ValueBool() {
+		final, err := waitForBackup(ctx, r.client, serverID, backup.UUID)
+		if err != nil {
+			// Keep the backup in state so it is tainted rather than orphaned.
+			resp.Diagnostics.AddError("Backup Failed", err.Error())
+		}
+		if final.UUID != "" {
+			backup = final
+		}
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(backup)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state backupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := state.ServerID.ValueString()
+	backup, err := getBackup(r.client, serverID, state.ID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch backup %s for server %s: %v", state.ID.ValueString(), serverID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromAPI(backup)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state backupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, uuid := state.ServerID.ValueString(), state.ID.ValueString()
+	if plan.IsLocked.ValueBool() != state.IsLocked.ValueBool() {
+		if err := toggleBackupLock(r.client, serverID, uuid); err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to change lock of backup %s for server %s: %v", uuid, serverID, err))
+			return
+		}
+	}
+
+	backup, err := getBackup(r.client, serverID, uuid)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch backup %s for server %s: %v", uuid, serverID, err))
+		return
+	}
+	resp.Diagnostics.Append(plan.fromAPI(backup)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state backupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, uuid := state.ServerID.ValueString(), state.ID.ValueString()
+	if state.IsLocked.ValueBool() {
+		resp.Diagnostics.AddWarning("Locked Backup Kept",
+			fmt.Sprintf("Backup %s of server %s is locked and was not deleted; it is no longer managed by Terraform.", uuid, serverID))
+		return
+	}
+	if err := r.client.Delete(backupPath(serverID, uuid)); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete backup %s for server %s: %v", uuid, serverID, err))
+	}
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource               = &ServerBackupRetentionResource{}
+	_ resource.ResourceWithModifyPlan = &ServerBackupRetentionResource{}
+)
+
+// ServerBackupRetentionResource keeps only the newest N backups of a server.
+type ServerBackupRetentionResource struct {
+	resourceBase
+}
+
+// backupRetentionModel holds the resource state.
+type backupRetentionModel struct {
+	ServerID    types.String `tfsdk:"server_id"`
+	Keep        types.Int64  `tfsdk:"keep"`
+	NamePrefix  types.String `tfsdk:"name_prefix"`
+	BackupUUIDs types.List   `tfsdk:"backup_uuids"` // prunable backups, newest first
+	ID          types.String `tfsdk:"id"`           // synthetic: "<server_id>-retention"
+}
+
+func NewServerBackupRetentionResource() resource.Resource {
+	return &ServerBackupRetentionResource{resourceBase: newResourceBase("kineticpanel_server_backup_retention", scopeClient)}
+}
+
+func (r *ServerBackupRetentionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_backup_retention"
+}
+
+func (r *ServerBackupRetentionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importComposite(ctx, req, resp, serverIDPart)
+}
+
+func (r *ServerBackupRetentionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Keeps only the newest `keep` backups of a Kinetic Panel server (Client API) whose name starts with `name_prefix`, so backups created by Terraform do not hit the panel's backup limit. " +
+			"Whenever more backups exist the plan shows an update, and applying it deletes the oldest ones. Locked and unfinished backups are never deleted. " +
+			"Destroying the resource deletes nothing.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"keep": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Number of backups to keep.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Only backups whose name starts with this prefix count toward `keep` and may be deleted, e.g. the prefix of the names given to `kineticpanel_server_backup`. Manual and scheduled backups with other names are left alone.",
+			},
+			"backup_uuids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "UUIDs of the backups covered by the retention, newest first.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-retention`).",
+			},
+		},
+	}
+}
+
+// ModifyPlan plans an update whenever the last refresh found more backups than keep.
+func (r *ServerBackupRetentionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var plan, state backupRetentionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Keep.IsUnknown() || state.BackupUUIDs.IsNull() {
+		return
+	}
+	if int64(len(state.BackupUUIDs.Elements())) > plan.Keep.ValueInt64() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("backup_uuids"), types.ListUnknown(types.StringType))...)
+	}
+}
+
+// observe lists the backups covered by the retention into m.
+func (r *ServerBackupRetentionResource) observe(m *backupRetentionModel) error {
+	backups, err := listBackups(r.client, m.ServerID.ValueString())
+	if err != nil {
+		return err
+	}
+	uuids := []string{}
+	for _, b := range prunableBackups(backups, m.NamePrefix.ValueString()) {
+		uuids = append(uuids, b.UUID)
+	}
+	list, diags := stringListValue(uuids)
+	if diags.HasError() {
+		return fmt.Errorf("%v", diags)
+	}
+	m.BackupUUIDs = list
+	m.ID = types.StringValue(m.ServerID.ValueString() + "-retention")
+	return nil
+}
+
+// apply prunes the backups beyond keep and refreshes m.
+func (r *ServerBackupRetentionResource) apply(m *backupRetentionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	serverID := m.ServerID.ValueString()
+	if _, err := pruneBackupsWithPrefix(r.client, serverID, int(m.Keep.ValueInt64()), m.NamePrefix.ValueString()); err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to prune backups for server %s: %v", serverID, err))
+		return diags
+	}
+	if err := r.observe(m); err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to list backups for server %s: %v", serverID, err))
+	}
+	return diags
+}
+
+func (r *ServerBackupRetentionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan backupRetentionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerBackupRetentionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state backupRetentionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.observe(&state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list backups for server %s: %v", state.ServerID.ValueString(), err))
+		return
+	}
+	// Imports keep every existing backup until keep is lowered.
+	if state.Keep.IsNull() {
+		state.Keep = types.Int64Value(int64(len(state.BackupUUIDs.Elements())))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerBackupRetentionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan backupRetentionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerBackupRetentionResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: remaining backups are kept
+	resp.State.RemoveResource(ctx)
+}
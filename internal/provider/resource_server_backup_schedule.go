@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ServerBackupScheduleResource{}
+
+// ServerBackupScheduleResource manages a schedule whose only task takes a backup.
+type ServerBackupScheduleResource struct {
+	resourceBase
+}
+
+// backupScheduleModel holds the resource state.
+type backupScheduleModel struct {
+	ServerID       types.String `tfsdk:"server_id"`
+	Name           types.String `tfsdk:"name"`
+	Cron           types.String `tfsdk:"cron"` // e.g. "0 3 * * *"
+	IsActive       types.Bool   `tfsdk:"is_active"`
+	OnlyWhenOnline types.Bool   `tfsdk:"only_when_online"`
+	IgnoredFiles   types.List   `tfsdk:"ignored_files"`
+	ExecuteNow     types.String `tfsdk:"execute_now"` // run the schedule when changed
+	ScheduleID     types.Int64  `tfsdk:"schedule_id"`
+	NextRunAt      types.String `tfsdk:"next_run_at"`
+	ID             types.String `tfsdk:"id"` // synthetic: "<server_id>-schedule-<schedule_id>"
+}
+
+func NewServerBackupScheduleResource() resource.Resource {
+	return &ServerBackupScheduleResource{resourceBase: newResourceBase("kineticpanel_server_backup_schedule", scopeClient)}
+}
+
+func (r *ServerBackupScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_backup_schedule"
+}
+
+func (r *ServerBackupScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importComposite(ctx, req, resp, serverIDPart, int64Part("schedule_id", "<schedule_id>"))
+}
+
+func (r *ServerBackupScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a schedule that backs up a Kinetic Panel server on a cron expression (Client API). " +
+			"When the server's backup limit is reached, the panel deletes the oldest unlocked backup before a scheduled backup runs. " +
+			"To keep fewer backups, use `kineticpanel_server_backup_retention`.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schedule name shown in the panel. Default: `Automatic backup`.",
+			},
+			"cron": schema.StringAttribute{
+				Required:    true,
+				Description: "Cron expression with five fields: minute, hour, day of month, month, day of week (e.g. `0 3 * * *` for 03:00 every night). Times are in the panel's timezone.",
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the schedule runs. Default: true.",
+			},
+			"only_when_online": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only run while the server is online. Default: false.",
+			},
+			"ignored_files": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Paths to exclude from the backup, in `.pteroignore` syntax.",
+			},
+			"execute_now": schema.StringAttribute{
+				Optional:    true,
+				Description: "Run the schedule once right away whenever this value is set or changed (e.g. a timestamp), regardless of `cron` and `is_active`. Useful to test a new schedule.",
+			},
+			"schedule_id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "ID of the underlying schedule.",
+			},
+			"next_run_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the schedule runs next, as reported by the panel.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-schedule-<schedule_id>`).",
+			},
+		},
+	}
+}
+
+// spec builds the schedule and its single backup task from the model.
+func (r *ServerBackupScheduleResource) spec(ctx context.Context, m backupScheduleModel) (scheduleSpec, []scheduleTask, error) {
+	name := "Automatic backup"
+	if !m.Name.IsNull() {
+		name = m.Name.ValueString()
+	}
+	isActive := true
+	if !m.IsActive.IsNull() {
+		isActive = m.IsActive.ValueBool()
+	}
+
+	var ignored []string
+	if !m.IgnoredFiles.IsNull() {
+		if diags := m.IgnoredFiles.ElementsAs(ctx, &ignored, false); diags.HasError() {
+			return scheduleSpec{}, nil, fmt.Errorf("invalid ignored_files: %v", diags)
+		}
+	}
+
+	spec := scheduleSpec{
+		Name:           name,
+		Cron:           m.Cron.ValueString(),
+		IsActive:       isActive,
+		OnlyWhenOnline: m.OnlyWhenOnline.ValueBool(),
+	}
+	tasks := []scheduleTask{{Action: "backup", Payload: strings.Join(ignored, "\n")}}
+	return spec, tasks, nil
+}
+
+// setFromSchedule copies the API view of the schedule into the model.
+func (r *ServerBackupScheduleResource) setFromSchedule(m *backupScheduleModel, s scheduleAttributes) {
+	m.ScheduleID = types.Int64Value(s.ID)
+	// Keep the configured spacing when the fields are the same.
+	if strings.Join(strings.Fields(m.Cron.ValueString()), " ") != s.cronExpression() {
+		m.Cron = types.StringValue(s.cronExpression())
+	}
+	m.NextRunAt = types.StringValue(s.NextRunAt)
+	m.ID = types.StringValue(m.ServerID.ValueString() + "-schedule-" + strconv.FormatInt(s.ID, 10))
+}
+
+// execute runs the schedule for execute_now; failures are warnings since the schedule itself is fine.
+func (r *ServerBackupScheduleResource) execute(ctx context.Context, m backupScheduleModel, diags *diag.Diagnostics) {
+	if err := executeSchedule(r.client, m.ServerID.ValueString(), m.ScheduleID.ValueInt64()); err != nil {
+		diags.AddWarning("Schedule Execution Failed", err.Error())
+		return
+	}
+	tflog.Info(ctx, "Executed schedule", map[string]any{"server_id": m.ServerID.ValueString(), "schedule_id": m.ScheduleID.ValueInt64()})
+}
+
+func (r *ServerBackupScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan backupScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spec, tasks, err := r.spec(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Backup Schedule", err.Error())
+		return
+	}
+	schedule, err := createSchedule(r.client, plan.ServerID.ValueString(), spec, tasks)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create backup schedule for server %s: %v", plan.ServerID.ValueString(), err))
+		return
+	}
+
+	r.setFromSchedule(&plan, schedule)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	if !plan.ExecuteNow.IsNull() {
+		r.execute(ctx, plan, &resp.Diagnostics)
+	}
+}
+
+func (r *ServerBackupScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state backupScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := getSchedule(r.client, state.ServerID.ValueString(), state.ScheduleID.ValueInt64())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read backup schedule for server %s: %v", state.ServerID.ValueString(), err))
+		return
+	}
+
+	r.setFromSchedule(&state, schedule)
+	// Optional settings left unset stay null while the panel matches the default.
+	if !state.Name.IsNull() || schedule.Name != "Automatic backup" {
+		state.Name = types.StringValue(schedule.Name)
+	}
+	if !state.IsActive.IsNull() || !schedule.IsActive {
+		state.IsActive = types.BoolValue(schedule.IsActive)
+	}
+	if !state.OnlyWhenOnline.IsNull() || schedule.OnlyWhenOnline {
+		state.OnlyWhenOnline = types.BoolValue(schedule.OnlyWhenOnline)
+	}
+
+	// Report the backup task's ignore list; an empty list stays null when unset.
+	for _, t := range schedule.Relationships.Tasks.Data {
+		if t.Attributes.Action != "backup" {
+			continue
+		}
+		if t.Attributes.Payload != "" || !state.IgnoredFiles.IsNull() {
+			var ignored []string
+			if t.Attributes.Payload != "" {
+				ignored = strings.Split(t.Attributes.Payload, "\n")
+			}
+			list, diags := stringListValue(ignored)
+			resp.Diagnostics.Append(diags...)
+			state.IgnoredFiles = list
+		}
+		break
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerBackupScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state backupScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spec, tasks, err := r.spec(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Backup Schedule", err.Error())
+		return
+	}
+	schedule, err := updateSchedule(r.client, plan.ServerID.ValueString(), state.ScheduleID.ValueInt64(), spec, tasks)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update backup schedule for server %s: %v", plan.ServerID.ValueString(), err))
+		return
+	}
+
+	r.setFromSchedule(&plan, schedule)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	if !plan.ExecuteNow.IsNull() && !plan.ExecuteNow.Equal(state.ExecuteNow) {
+		r.execute(ctx, plan, &resp.Diagnostics)
+	}
+}
+
+func (r *ServerBackupScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state backupScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Backups already taken are kept.
+	err := r.client.Delete(schedulePath(state.ServerID.ValueString(), state.ScheduleID.ValueInt64()))
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete backup schedule for server %s: %v", state.ServerID.ValueString(), err))
+	}
+}
@@ -0,0 +1,378 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ServerBlueGreenResource{}
+
+// ServerBlueGreenResource brings up a replacement ("green") server next to a
+// running one ("blue") and optionally moves the blue server's port to it.
+type ServerBlueGreenResource struct {
+	resourceBase
+}
+
+// serverBlueGreenModel holds the resource state.
+type serverBlueGreenModel struct {
+	BlueServerID      types.Int64  `tfsdk:"blue_server_id"`
+	Name              types.String `tfsdk:"name"`
+	AllocationID      types.Int64  `tfsdk:"allocation_id"`
+	Environment       types.Map    `tfsdk:"environment"` // overrides of the blue server's variables
+	Triggers          types.Map    `tfsdk:"triggers"`    // deploy again when changed
+	Cutover           types.String `tfsdk:"cutover"`     // report or swap
+	SpareAllocationID types.Int64  `tfsdk:"spare_allocation_id"`
+	ClientAPIKey      types.String `tfsdk:"client_api_key"`
+	Timeout           types.Int64  `tfsdk:"timeout_seconds"` // default 1800
+	Identifier        types.String `tfsdk:"identifier"`
+	GreenAddress      types.String `tfsdk:"green_address"`
+	BlueAddress       types.String `tfsdk:"blue_address"`
+	Swapped           types.Bool   `tfsdk:"swapped"`
+	ID                types.Int64  `tfsdk:"id"` // numeric ID of the green server
+}
+
+func NewServerBlueGreenResource() resource.Resource {
+	return &ServerBlueGreenResource{resourceBase: newResourceBase("kineticpanel_server_blue_green", scopeApplication)}
+}
+
+func (r *ServerBlueGreenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_blue_green"
+}
+
+func (r *ServerBlueGreenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Blue/green redeploy (Application API, plus a Client API key for power and state): creates a green server with the blue server's configuration and `environment` overrides " +
+			"(e.g. a new modpack version), waits for it to install, starts it and waits until it is running. Then, with `cutover = \"report\"`, it only reports both addresses " +
+			"so DNS or a proxy can be switched; with `cutover = \"swap\"` the green server takes over the blue server's primary port, the blue server moves to the green server's " +
+			"original port, and both are restarted. Changing any argument replaces the resource, deploying a new green server. " +
+			"Destroying the resource deletes the green server, unless it was swapped in: it then serves production and is kept.",
+		Attributes: map[string]schema.Attribute{
+			"blue_server_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Numeric ID of the running server to replace.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the green server.",
+			},
+			"allocation_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Free allocation for the green server until cutover.",
+			},
+			"environment": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Startup variables that differ from the blue server (e.g. `{ MODPACK_VERSION = \"1.4.0\" }`).",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that deploy a new green server when changed.",
+			},
+			"cutover": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("report", "swap"),
+				},
+				Description: "`report` leaves the ports alone; `swap` moves the blue server's primary port to the green server. Default: `report`.",
+			},
+			"spare_allocation_id": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Free allocation on the same node used to shuffle ports during a swap (a server's primary port cannot be released while it has no other). Required with `cutover = \"swap\"`; it is free again afterwards.",
+			},
+			"client_api_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Client API key with access to both servers, used to start them and watch their state.",
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+				Description: "Maximum time for the green server to install and come online. Default: 1800.",
+			},
+			"identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Short identifier of the green server.",
+			},
+			"green_address": schema.StringAttribute{
+				Computed:    true,
+				Description: "`ip:port` of the green server's primary allocation.",
+			},
+			"blue_address": schema.StringAttribute{
+				Computed:    true,
+				Description: "`ip:port` of the blue server's primary allocation.",
+			},
+			"swapped": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the ports were swapped.",
+			},
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Numeric ID of the green server.",
+			},
+		},
+	}
+}
+
+// moveAllocation makes to the primary allocation of server and releases from.
+func moveAllocation(client *Client, serverID, from, to int64) error {
+	build, err := getAppServerBuild(client, serverID)
+	if err != nil {
+		return err
+	}
+	return updateAppServerBuild(client, build, map[string]any{
+		"allocation":         to,
+		"add_allocations":    []int64{to},
+		"remove_allocations": []int64{from},
+	})
+}
+
+// swap gives the blue server's primary allocation to the green server and the
+// green server's to the blue one, shuffling through spare.
+func (r *ServerBlueGreenResource) swap(blueID, greenID, spare int64) error {
+	blue, err := getAppServerBuild(r.client, blueID)
+	if err != nil {
+		return err
+	}
+	green, err := getAppServerBuild(r.client, greenID)
+	if err != nil {
+		return err
+	}
+	steps := []struct {
+		server, from, to int64
+	}{
+		{greenID, green.Allocation, spare},
+		{blueID, blue.Allocation, green.Allocation},
+		{greenID, spare, blue.Allocation},
+	}
+	for _, s := range steps {
+		if err := moveAllocation(r.client, s.server, s.from, s.to); err != nil {
+			return fmt.Errorf("move server %d from allocation %d to %d: %w", s.server, s.from, s.to, err)
+		}
+	}
+	return nil
+}
+
+func sendPower(client *Client, identifier, signal string) error {
+	_, err := client.Post("/servers/"+identifier+"/power", map[string]string{"signal": signal})
+	return err
+}
+
+// addresses refreshes the primary addresses of both servers into m.
+func (r *ServerBlueGreenResource) addresses(m *serverBlueGreenModel) error {
+	green, err := getAppServerBuild(r.client, m.ID.ValueInt64())
+	if err != nil {
+		return err
+	}
+	blue, err := getAppServerBuild(r.client, m.BlueServerID.ValueInt64())
+	if err != nil {
+		return err
+	}
+	m.GreenAddress = types.StringValue(green.primaryAddress())
+	m.BlueAddress = types.StringValue(blue.primaryAddress())
+	return nil
+}
+
+func (r *ServerBlueGreenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverBlueGreenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	swap := plan.Cutover.ValueString() == "swap"
+	if swap && plan.SpareAllocationID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("spare_allocation_id"), "Missing Spare Allocation", "cutover = \"swap\" needs a free spare allocation to move the ports through.")
+		return
+	}
+	overrides := map[string]string{}
+	if !plan.Environment.IsNull() {
+		resp.Diagnostics.Append(plan.Environment.ElementsAs(ctx, &overrides, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	blueID := plan.BlueServerID.ValueInt64()
+	blue, err := getAppServerIdentity(r.client, blueID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch server %d: %v", blueID, err))
+		return
+	}
+	startup, err := getAppServerStartup(r.client, blueID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch startup of server %d: %v", blueID, err))
+		return
+	}
+	build, err := getAppServerBuild(r.client, blueID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch build of server %d: %v", blueID, err))
+		return
+	}
+
+	payload := clonePayload(plan.Name.ValueString(), blue.User, plan.AllocationID.ValueInt64(), startup, build)
+	env := startup.environment()
+	for k, v := range overrides {
+		env[k] = v
+	}
+	payload["environment"] = env
+
+	tflog.Info(ctx, "Creating green server", map[string]any{"blue": blueID, "name": plan.Name.ValueString()})
+	body, err := r.client.Post("/servers", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create green server for server %d: %v", blueID, err))
+		return
+	}
+	var created struct {
+		Attributes appServerIdentity `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		resp.Diagnostics.AddError("JSON Parse Error", err.Error())
+		return
+	}
+	greenID, greenIdent := created.Attributes.ID, created.Attributes.Identifier
+	plan.ID = types.Int64Value(greenID)
+	plan.Identifier = types.StringValue(greenIdent)
+	plan.Swapped = types.BoolValue(false)
+	plan.GreenAddress = types.StringUnknown()
+	plan.BlueAddress = types.StringUnknown()
+
+	// From here on, keep the green server in state on failure so it is
+	// tainted rather than orphaned.
+	defer func() {
+		if err := r.addresses(&plan); err != nil {
+			resp.Diagnostics.AddWarning("Addresses Unknown", fmt.Sprintf("Failed to read the servers' allocations: %v", err))
+			plan.GreenAddress, plan.BlueAddress = types.StringValue(""), types.StringValue("")
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	}()
+
+	timeout := 1800 * time.Second
+	if !plan.Timeout.IsNull() {
+		timeout = time.Duration(plan.Timeout.ValueInt64()) * time.Second
+	}
+	deadline := time.Now().Add(timeout)
+	if _, err := waitForInstall(ctx, r.client, greenID, timeout); err != nil {
+		resp.Diagnostics.AddError("Server Install Failed", err.Error())
+		return
+	}
+
+	cc := r.client.clientAPI(plan.ClientAPIKey.ValueString())
+	if err := sendPower(cc, greenIdent, "start"); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to start green server %s: %v", greenIdent, err))
+		return
+	}
+	if err := waitForClientState(ctx, cc, greenIdent, "running", time.Until(deadline), 5*time.Second); err != nil {
+		resp.Diagnostics.AddError("Green Server Not Running", err.Error())
+		return
+	}
+	if !swap {
+		return
+	}
+
+	if err := r.swap(blueID, greenID, plan.SpareAllocationID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Swap Failed", err.Error()+". Check both servers' allocations in the panel before retrying.")
+		return
+	}
+	plan.Swapped = types.BoolValue(true)
+	// The servers bind their new ports on restart.
+	if err := sendPower(cc, greenIdent, "restart"); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to restart green server %s: %v", greenIdent, err))
+		return
+	}
+	if err := sendPower(cc, blue.Identifier, "restart"); err != nil {
+		resp.Diagnostics.AddWarning("Blue Server Not Restarted", fmt.Sprintf("Failed to restart server %s on its new port: %v", blue.Identifier, err))
+	}
+}
+
+func (r *ServerBlueGreenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverBlueGreenModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.addresses(&state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch allocations of server %d: %v", state.ID.ValueInt64(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerBlueGreenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverBlueGreenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only client_api_key and timeout_seconds change in place; both matter on create only.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerBlueGreenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverBlueGreenModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+	if state.Swapped.ValueBool() {
+		resp.Diagnostics.AddWarning("Green Server Kept",
+			fmt.Sprintf("Server %d took over the production port and was not deleted; it is no longer managed by Terraform.", id))
+		return
+	}
+	if err := r.client.Delete("/servers/" + strconv.FormatInt(id, 10)); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete server %d: %v", id, err))
+	}
+}
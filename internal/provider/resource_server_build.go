@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ServerBuildResource{}
+
+// ServerBuildResource manages the limits of an existing server as an administrator.
+type ServerBuildResource struct {
+	resourceBase
+}
+
+// serverBuildModel holds the resource state. Unset limits keep their current value.
+type serverBuildModel struct {
+	ServerID        types.Int64  `tfsdk:"server_id"`
+	Memory          types.Int64  `tfsdk:"memory"`
+	Swap            types.Int64  `tfsdk:"swap"`
+	Disk            types.Int64  `tfsdk:"disk"`
+	IO              types.Int64  `tfsdk:"io"`
+	CPU             types.Int64  `tfsdk:"cpu"`
+	Threads         types.String `tfsdk:"threads"`
+	OOMDisabled     types.Bool   `tfsdk:"oom_disabled"`
+	DatabaseLimit   types.Int64  `tfsdk:"database_limit"`
+	AllocationLimit types.Int64  `tfsdk:"allocation_limit"`
+	BackupLimit     types.Int64  `tfsdk:"backup_limit"`
+	ID              types.String `tfsdk:"id"` // synthetic: "<server_id>-build"
+}
+
+func NewServerBuildResource() resource.Resource {
+	return &ServerBuildResource{resourceBase: newResourceBase("kineticpanel_server_build", scopeApplication)}
+}
+
+func (r *ServerBuildResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_build"
+}
+
+func (r *ServerBuildResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", "Expected the numeric server ID.")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), id)...)
+}
+
+// buildLimitAttribute is an optional limit that reads back the panel's value when unset.
+func buildLimitAttribute(v validator.Int64, description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Int64{
+			v,
+		},
+		Description: description + " Default: the current value.",
+	}
+}
+
+func (r *ServerBuildResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the resource and feature limits of an existing server (Application API), e.g. one created in the panel UI, without importing the whole server. " +
+			"Only configured limits are managed; the rest are read back. Destroying the resource leaves the limits as they are. " +
+			"Do not combine with `kineticpanel_server` for the same server, which manages memory, disk and cpu itself.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Numeric server ID.",
+			},
+			"memory": buildLimitAttribute(memoryLimitValidator(), fmt.Sprintf("Memory limit in MiB: 0 for unlimited or at least %d.", minMemoryMiB)),
+			"swap":   buildLimitAttribute(int64validator.AtLeast(-1), "Swap in MiB, 0 to disable and -1 for unlimited."),
+			"disk":   buildLimitAttribute(diskLimitValidator(), fmt.Sprintf("Disk limit in MiB: 0 for unlimited or at least %d.", minDiskMiB)),
+			"io":     buildLimitAttribute(int64validator.Between(10, 1000), "Block IO weight (10-1000)."),
+			"cpu":    buildLimitAttribute(cpuLimitValidator(), fmt.Sprintf("CPU limit in percent of one core: 0 for unlimited or at least %d.", minCPUPercent)),
+			"threads": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "CPU cores to pin the server to, e.g. `0-3` or `0,2`. Default: the current value.",
+			},
+			"oom_disabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Disable the OOM killer. Default: the current value.",
+			},
+			"database_limit":   buildLimitAttribute(int64validator.AtLeast(0), "Number of databases the server may create."),
+			"allocation_limit": buildLimitAttribute(int64validator.AtLeast(0), "Number of allocations the server may assign itself."),
+			"backup_limit":     buildLimitAttribute(int64validator.AtLeast(0), "Number of backups the server may keep."),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-build`).",
+			},
+		},
+	}
+}
+
+// apply patches the configured limits onto the current build.
+func (r *ServerBuildResource) apply(plan serverBuildModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	serverID := plan.ServerID.ValueInt64()
+	build, err := getAppServerBuild(r.client, serverID)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to fetch build for server %d: %v", serverID, err))
+		return diags
+	}
+
+	set := func(v types.Int64, field *int64) {
+		if !v.IsNull() && !v.IsUnknown() {
+			*field = v.ValueInt64()
+		}
+	}
+	set(plan.Memory, &build.Limits.Memory)
+	set(plan.Swap, &build.Limits.Swap)
+	set(plan.Disk, &build.Limits.Disk)
+	set(plan.IO, &build.Limits.IO)
+	set(plan.CPU, &build.Limits.CPU)
+	set(plan.DatabaseLimit, &build.FeatureLimits.Databases)
+	set(plan.AllocationLimit, &build.FeatureLimits.Allocations)
+	set(plan.BackupLimit, &build.FeatureLimits.Backups)
+	if !plan.Threads.IsNull() && !plan.Threads.IsUnknown() {
+		build.Limits.Threads = plan.Threads.ValueStringPointer()
+	}
+	if !plan.OOMDisabled.IsNull() && !plan.OOMDisabled.IsUnknown() {
+		build.Limits.OOMDisabled = plan.OOMDisabled.ValueBool()
+	}
+
+	if err := updateAppServerBuild(r.client, build, nil); err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to update build for server %d: %v", serverID, err))
+	}
+	return diags
+}
+
+// read copies the panel's build into state.
+func (r *ServerBuildResource) read(state *serverBuildModel) error {
+	serverID := state.ServerID.ValueInt64()
+	build, err := getAppServerBuild(r.client, serverID)
+	if err != nil {
+		return err
+	}
+	state.Memory = types.Int64Value(build.Limits.Memory)
+	state.Swap = types.Int64Value(build.Limits.Swap)
+	state.Disk = types.Int64Value(build.Limits.Disk)
+	state.IO = types.Int64Value(build.Limits.IO)
+	state.CPU = types.Int64Value(build.Limits.CPU)
+	state.Threads = types.StringPointerValue(build.Limits.Threads)
+	state.OOMDisabled = types.BoolValue(build.Limits.OOMDisabled)
+	state.DatabaseLimit = types.Int64Value(build.FeatureLimits.Databases)
+	state.AllocationLimit = types.Int64Value(build.FeatureLimits.Allocations)
+	state.BackupLimit = types.Int64Value(build.FeatureLimits.Backups)
+	state.ID = types.StringValue(strconv.FormatInt(serverID, 10) + "-build")
+	return nil
+}
+
+func (r *ServerBuildResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverBuildModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.read(&plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch build for server %d: %v", plan.ServerID.ValueInt64(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerBuildResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverBuildModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(&state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch build for server %d: %v", state.ServerID.ValueInt64(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerBuildResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverBuildModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.read(&plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch build for server %d: %v", plan.ServerID.ValueInt64(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerBuildResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: there are no limits to return to, so the server keeps the current ones
+	resp.State.RemoveResource(ctx)
+}
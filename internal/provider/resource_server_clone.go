@@ -0,0 +1,359 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ServerCloneResource{}
+
+// ServerCloneResource creates a server with the configuration of another one.
+type ServerCloneResource struct {
+	resourceBase
+}
+
+// serverCloneModel holds the resource state.
+type serverCloneModel struct {
+	SourceServerID      types.Int64  `tfsdk:"source_server_id"`
+	Name                types.String `tfsdk:"name"`
+	UserID              types.Int64  `tfsdk:"user_id"` // defaults to the source's owner
+	AllocationID        types.Int64  `tfsdk:"allocation_id"`
+	RestoreLatestBackup types.Bool   `tfsdk:"restore_latest_backup"`
+	ClientAPIKey        types.String `tfsdk:"client_api_key"`
+	InstallTimeout      types.Int64  `tfsdk:"install_timeout_seconds"` // default 900
+	Identifier          types.String `tfsdk:"identifier"`
+	EggID               types.Int64  `tfsdk:"egg_id"`
+	RestoredBackupUUID  types.String `tfsdk:"restored_backup_uuid"`
+	ID                  types.Int64  `tfsdk:"id"` // numeric ID of the clone
+}
+
+func NewServerCloneResource() resource.Resource {
+	return &ServerCloneResource{resourceBase: newResourceBase("kineticpanel_server_clone", scopeApplication)}
+}
+
+func (r *ServerCloneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_clone"
+}
+
+func (r *ServerCloneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a new server with the egg, docker image, startup command, variables and limits of an existing server (Application API), e.g. a staging copy of a production server. " +
+			"With `restore_latest_backup` the source's newest successful backup is downloaded and extracted into the clone once it has installed; the panel cannot restore a backup " +
+			"into another server, so this needs a Client API key with access to both servers and room for the archive in the provider's temp directory. " +
+			"The configuration is copied on create only. Destroying the resource deletes the clone.",
+		Attributes: map[string]schema.Attribute{
+			"source_server_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Numeric ID of the server to clone.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the clone.",
+			},
+			"user_id": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Owner of the clone. Default: the owner of the source server.",
+			},
+			"allocation_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Free allocation for the clone's primary port; it also picks the node the clone runs on.",
+			},
+			"restore_latest_backup": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Description: "Copy the files of the source's latest successful backup into the clone. Default: false.",
+			},
+			"client_api_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("restore_latest_backup")),
+				},
+				Description: "Client API key used to download the backup and upload it into the clone. Required with `restore_latest_backup`.",
+			},
+			"install_timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the clone to install before restoring the backup. Default: 900.",
+			},
+			"identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Short identifier of the clone, for resources that use the Client API.",
+			},
+			"egg_id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"restored_backup_uuid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "UUID of the source backup copied into the clone, or null.",
+			},
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Numeric ID of the clone.",
+			},
+		},
+	}
+}
+
+// appServerIdentity is the ownership part of a server on the Application API.
+type appServerIdentity struct {
+	ID         int64  `json:"id"`
+	Identifier string `json:"identifier"`
+	Name       string `json:"name"`
+	User       int64  `json:"user"`
+	Egg        int64  `json:"egg"`
+}
+
+func getAppServerIdentity(client *Client, serverID int64) (appServerIdentity, error) {
+	body, err := client.Get("/servers/" + strconv.FormatInt(serverID, 10))
+	if err != nil {
+		return appServerIdentity{}, err
+	}
+	var apiResp struct {
+		Attributes appServerIdentity `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return appServerIdentity{}, err
+	}
+	return apiResp.Attributes, nil
+}
+
+// clonePayload builds the create request for a copy of a server with the
+// given startup and build configuration on allocationID.
+func clonePayload(name string, user, allocationID int64, startup appServerStartup, build appServerBuild) map[string]any {
+	return map[string]any{
+		"name":         name,
+		"user":         user,
+		"egg":          startup.Egg,
+		"docker_image": startup.Container.Image,
+		"startup":      startup.Container.StartupCommand,
+		"environment":  startup.environment(),
+		"limits": map[string]any{
+			"memory":       build.Limits.Memory,
+			"swap":         build.Limits.Swap,
+			"disk":         build.Limits.Disk,
+			"io":           build.Limits.IO,
+			"cpu":          build.Limits.CPU,
+			"threads":      build.Limits.Threads,
+			"oom_disabled": build.Limits.OOMDisabled,
+		},
+		"feature_limits": map[string]int64{
+			"databases":   build.FeatureLimits.Databases,
+			"allocations": build.FeatureLimits.Allocations,
+			"backups":     build.FeatureLimits.Backups,
+		},
+		"allocation": map[string]int64{"default": allocationID},
+	}
+}
+
+// restoreLatestBackup copies the files of the source's latest backup into
+// the clone: download the archive, upload it, extract it, delete it.
+func (r *ServerCloneResource) restoreLatestBackup(ctx context.Context, apiKey, source, target string) (string, error) {
+	cc := r.client.clientAPI(apiKey)
+
+	backups, err := listBackups(cc, source)
+	if err != nil {
+		return "", fmt.Errorf("list backups of %s: %w", source, err)
+	}
+	backup, ok := latestBackup(backups)
+	if !ok {
+		return "", fmt.Errorf("server %s has no successful backup", source)
+	}
+
+	dir, err := os.MkdirTemp("", "kineticpanel-clone-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+	archive := filepath.Join(dir, "restore-"+backup.UUID+".tar.gz")
+
+	tflog.Info(ctx, "Downloading backup", map[string]any{"server_id": source, "uuid": backup.UUID, "bytes": backup.Bytes})
+	if _, err := downloadBackup(ctx, cc, source, backup.UUID, archive); err != nil {
+		return "", fmt.Errorf("download backup %s: %w", backup.UUID, err)
+	}
+	if err := uploadServerFile(ctx, cc, target, "/", archive, 3); err != nil {
+		return "", fmt.Errorf("upload backup to %s: %w", target, err)
+	}
+	name := filepath.Base(archive)
+	if err := decompressServerFile(cc, target, "/", name); err != nil {
+		return "", fmt.Errorf("extract backup on %s: %w", target, err)
+	}
+	if err := deleteServerFiles(cc, target, "/", name); err != nil {
+		tflog.Warn(ctx, "Failed to delete restored archive", map[string]any{"server_id": target, "error": err.Error()})
+	}
+	return backup.UUID, nil
+}
+
+func (r *ServerCloneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverCloneModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restore := plan.RestoreLatestBackup.ValueBool()
+	if restore && plan.ClientAPIKey.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("client_api_key"), "Missing Client API Key", "restore_latest_backup needs a Client API key to download and upload the backup.")
+		return
+	}
+
+	srcID := plan.SourceServerID.ValueInt64()
+	src, err := getAppServerIdentity(r.client, srcID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch server %d: %v", srcID, err))
+		return
+	}
+	startup, err := getAppServerStartup(r.client, srcID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch startup of server %d: %v", srcID, err))
+		return
+	}
+	build, err := getAppServerBuild(r.client, srcID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch build of server %d: %v", srcID, err))
+		return
+	}
+
+	user := src.User
+	if !plan.UserID.IsUnknown() && !plan.UserID.IsNull() {
+		user = plan.UserID.ValueInt64()
+	}
+	tflog.Info(ctx, "Cloning server", map[string]any{"source": srcID, "name": plan.Name.ValueString()})
+	body, err := r.client.Post("/servers", clonePayload(plan.Name.ValueString(), user, plan.AllocationID.ValueInt64(), startup, build))
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create clone of server %d: %v", srcID, err))
+		return
+	}
+	var created struct {
+		Attributes appServerIdentity `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		resp.Diagnostics.AddError("JSON Parse Error", err.Error())
+		return
+	}
+
+	plan.ID = types.Int64Value(created.Attributes.ID)
+	plan.Identifier = types.StringValue(created.Attributes.Identifier)
+	plan.UserID = types.Int64Value(created.Attributes.User)
+	plan.EggID = types.Int64Value(created.Attributes.Egg)
+	plan.RestoredBackupUUID = types.StringNull()
+
+	if restore {
+		timeout := 900 * time.Second
+		if !plan.InstallTimeout.IsNull() {
+			timeout = time.Duration(plan.InstallTimeout.ValueInt64()) * time.Second
+		}
+		// Keep the clone in state on failure so it is tainted rather than orphaned.
+		if _, err := waitForInstall(ctx, r.client, created.Attributes.ID, timeout); err != nil {
+			resp.Diagnostics.AddError("Server Install Failed", err.Error())
+		} else if uuid, err := r.restoreLatestBackup(ctx, plan.ClientAPIKey.ValueString(), src.Identifier, created.Attributes.Identifier); err != nil {
+			resp.Diagnostics.AddError("Backup Restore Failed", err.Error())
+		} else {
+			plan.RestoredBackupUUID = types.StringValue(uuid)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerCloneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverCloneModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clone, err := getAppServerIdentity(r.client, state.ID.ValueInt64())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch server %d: %v", state.ID.ValueInt64(), err))
+		return
+	}
+
+	// Only ownership and name are tracked; the copied configuration is the
+	// clone's own from here on.
+	state.Name = types.StringValue(clone.Name)
+	state.UserID = types.Int64Value(clone.User)
+	state.Identifier = types.StringValue(clone.Identifier)
+	state.EggID = types.Int64Value(clone.Egg)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerCloneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state serverCloneModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		id := state.ID.ValueInt64()
+		_, err := r.client.Patch("/servers/"+strconv.FormatInt(id, 10)+"/details", map[string]any{
+			"name": plan.Name.ValueString(),
+			"user": state.UserID.ValueInt64(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to rename server %d: %v", id, err))
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerCloneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverCloneModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+	if err := r.client.Delete("/servers/" + strconv.FormatInt(id, 10)); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete server %d: %v", id, err))
+	}
+}
@@ -3,32 +3,46 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ resource.Resource = &ServerCommandResource{}
+var (
+	_ resource.Resource                   = &ServerCommandResource{}
+	_ resource.ResourceWithValidateConfig = &ServerCommandResource{}
+)
 
 // ServerCommandResource sends a console command to a Kinetic Panel server (Client API).
 type ServerCommandResource struct {
-	client *Client
+	resourceBase
 }
 
 // serverCommandModel holds the Terraform state for this resource.
 type serverCommandModel struct {
 	ServerID types.String `tfsdk:"server_id"` // short identifier, e.g. "1a2b3c"
 	Command  types.String `tfsdk:"command"`   // console command to run
+	Triggers types.Map    `tfsdk:"triggers"`  // re-run the command when changed
 	ID       types.String `tfsdk:"id"`        // synthetic ID (server_id + "-cmd")
+
+	ExpectOutputRegex types.String `tfsdk:"expect_output_regex"`    // console line that must follow
+	ExpectTimeout     types.Int64  `tfsdk:"expect_timeout_seconds"` // how long to wait for it (default 60)
+	MatchedOutput     types.String `tfsdk:"matched_output"`         // the line that matched
 }
 
 // NewServerCommandResource returns a new instance of the resource.
 func NewServerCommandResource() resource.Resource {
-	return &ServerCommandResource{}
+	return &ServerCommandResource{resourceBase: newResourceBase("kineticpanel_server_command", scopeClient)}
 }
 
 // Metadata sets the Terraform type name.
@@ -37,13 +51,15 @@ func (r *ServerCommandResource) Metadata(_ context.Context, req resource.Metadat
 }
 
 func (r *ServerCommandResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("server_id"), req, resp)
+	// The command cannot be read back, so it is part of the ID.
+	importComposite(ctx, req, resp, serverIDPart, importIDPart{Attr: "command", Label: "<command>"})
 }
 
 // Schema defines the resource attributes.
 func (r *ServerCommandResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Sends a console command to a Kinetic Panel server (Client API).",
+		Description: "Sends a console command to a Kinetic Panel server (Client API). The command runs on create; changing `command` or `triggers` replaces the resource, which runs it again. Destroying the resource runs nothing. " +
+			"With `expect_output_regex` the apply fails unless the console prints a matching line within `expect_timeout_seconds`; this needs the `websocket.connect` permission.",
 		Attributes: map[string]schema.Attribute{
 			"server_id": schema.StringAttribute{
 				Required: true,
@@ -53,9 +69,44 @@ func (r *ServerCommandResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "Server identifier (short ID, e.g. `1a2b3c`).",
 			},
 			"command": schema.StringAttribute{
-				Required:    true,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Description: "Console command to execute.",
 			},
+			"expect_output_regex": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Regular expression (RE2) a console line must match after the command was sent, e.g. `^Saved the game`. The resource is not created if no line matches in time.",
+			},
+			"expect_timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 3600),
+				},
+				Description: "How long to wait for `expect_output_regex`. Default: 60.",
+			},
+			"matched_output": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Console line that matched `expect_output_regex`, or null without it.",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that re-fire the command when changed (e.g. a timestamp or a hash of a config file).",
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -67,20 +118,25 @@ func (r *ServerCommandResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
-// Configure injects the HTTP client that was built in the provider.
-func (r *ServerCommandResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+func (r *ServerCommandResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config serverCommandModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.ExpectOutputRegex.IsNull() {
+		if !config.ExpectTimeout.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("expect_timeout_seconds"), "Invalid Attribute Combination",
+				"expect_timeout_seconds only applies together with expect_output_regex.")
+		}
 		return
 	}
-	client, ok := req.ProviderData.(*Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
-		)
+	if config.ExpectOutputRegex.IsUnknown() {
 		return
 	}
-	r.client = client
+	if _, err := regexp.Compile(config.ExpectOutputRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expect_output_regex"), "Invalid Regular Expression", err.Error())
+	}
 }
 
 // Create sends the command (first time the resource is applied).
@@ -91,7 +147,21 @@ func (r *ServerCommandResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	pth := "/servers/" + plan.ServerID.ValueString() + "/command"
+	serverID := plan.ServerID.ValueString()
+
+	// Connect before sending, so the response cannot be printed before we listen.
+	var conn *consoleConn
+	if !plan.ExpectOutputRegex.IsNull() {
+		var err error
+		conn, err = dialConsole(ctx, r.client, serverID, false)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to connect to the console of server %s: %v", serverID, err))
+			return
+		}
+		defer conn.Close()
+	}
+
+	pth := "/servers/" + serverID + "/command"
 	payload := map[string]string{"command": plan.Command.ValueString()}
 
 	_, err := r.client.Post(pth, payload)
@@ -100,7 +170,21 @@ func (r *ServerCommandResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-cmd")
+	plan.MatchedOutput = types.StringNull()
+	if conn != nil {
+		timeout := 60 * time.Second
+		if !plan.ExpectTimeout.IsNull() {
+			timeout = time.Duration(plan.ExpectTimeout.ValueInt64()) * time.Second
+		}
+		line, err := waitForConsoleLine(ctx, conn, regexp.MustCompile(plan.ExpectOutputRegex.ValueString()), time.Now().Add(timeout))
+		if err != nil {
+			resp.Diagnostics.AddError("Unexpected Command Output", fmt.Sprintf("Command %q on server %s: %v", plan.Command.ValueString(), serverID, err))
+			return
+		}
+		plan.MatchedOutput = types.StringValue(line)
+	}
+
+	plan.ID = types.StringValue(serverID + "-cmd")
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -114,24 +198,14 @@ func (r *ServerCommandResource) Read(ctx context.Context, req resource.ReadReque
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
-// Update re-sends the command when the `command` attribute changes.
+// Update never re-sends the command: changing `command` or `triggers` forces
+// replacement, so there is nothing to do beyond storing the plan.
 func (r *ServerCommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan serverCommandModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	pth := "/servers/" + plan.ServerID.ValueString() + "/command"
-	payload := map[string]string{"command": plan.Command.ValueString()}
-
-	_, err := r.client.Post(pth, payload)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to update command", err.Error())
-		return
-	}
-
-	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-cmd")
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &ServerCommandSequenceResource{}
+	_ resource.ResourceWithValidateConfig = &ServerCommandSequenceResource{}
+)
+
+// ServerCommandSequenceResource sends console commands one after another,
+// pausing or waiting for console output between them.
+type ServerCommandSequenceResource struct {
+	resourceBase
+}
+
+// serverCommandSequenceModel holds the resource state.
+type serverCommandSequenceModel struct {
+	ServerID types.String          `tfsdk:"server_id"`
+	Steps    []commandSequenceStep `tfsdk:"steps"`
+	Triggers types.Map             `tfsdk:"triggers"`
+	ID       types.String          `tfsdk:"id"` // synthetic: "<server_id>-cmdseq"
+}
+
+// commandSequenceStep is one command of the sequence.
+type commandSequenceStep struct {
+	Command     types.String `tfsdk:"command"`
+	WaitFor     types.String `tfsdk:"wait_for"`
+	WaitTimeout types.Int64  `tfsdk:"wait_timeout_seconds"`
+	Delay       types.Int64  `tfsdk:"delay_seconds"`
+}
+
+func NewServerCommandSequenceResource() resource.Resource {
+	return &ServerCommandSequenceResource{resourceBase: newResourceBase("kineticpanel_server_command_sequence", scopeClient)}
+}
+
+func (r *ServerCommandSequenceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_command_sequence"
+}
+
+func (r *ServerCommandSequenceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends console commands to a Kinetic Panel server (Client API) in order, e.g. announce → `save-all` → `stop`. " +
+			"Each step can wait for console output matching a regex and then pause before the next one. " +
+			"The sequence runs on create; changing `steps` or `triggers` replaces the resource, which runs it again. If a step fails, the resource is not created and the next apply starts over from the first step. " +
+			"Destroying the resource runs nothing. Steps with `wait_for` need the `websocket.connect` permission.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Server identifier (short ID, e.g. `1a2b3c`).",
+			},
+			"steps": schema.ListNestedAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				Description: "Commands to send, in order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+							Description: "Console command to execute.",
+						},
+						"wait_for": schema.StringAttribute{
+							Optional:    true,
+							Description: "Regular expression (RE2) a console line must match after the command was sent before the sequence continues, e.g. `Saved the game`.",
+						},
+						"wait_timeout_seconds": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 3600),
+							},
+							Description: "How long to wait for `wait_for` before failing. Default: 60.",
+						},
+						"delay_seconds": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 3600),
+							},
+							Description: "Pause after the step (and its `wait_for`) before the next one. Default: 0.",
+						},
+					},
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that re-run the sequence when changed (e.g. a timestamp or a hash of a config file).",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-cmdseq`).",
+			},
+		},
+	}
+}
+
+func (r *ServerCommandSequenceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config serverCommandSequenceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, step := range config.Steps {
+		if step.WaitFor.IsNull() || step.WaitFor.IsUnknown() {
+			if !step.WaitTimeout.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("steps").AtListIndex(i).AtName("wait_timeout_seconds"),
+					"Invalid Attribute Combination", "wait_timeout_seconds only applies together with wait_for.")
+			}
+			continue
+		}
+		if _, err := regexp.Compile(step.WaitFor.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("steps").AtListIndex(i).AtName("wait_for"), "Invalid Regular Expression", err.Error())
+		}
+	}
+}
+
+// run sends the steps in order. The console is connected before the first
+// command when any step waits for output, so nothing printed in between is missed.
+func (r *ServerCommandSequenceResource) run(ctx context.Context, serverID string, steps []commandSequenceStep) error {
+	var conn *consoleConn
+	for _, step := range steps {
+		if !step.WaitFor.IsNull() {
+			c, err := dialConsole(ctx, r.client, serverID, false)
+			if err != nil {
+				return fmt.Errorf("connect to console: %w", err)
+			}
+			conn = c
+			defer conn.Close()
+			break
+		}
+	}
+
+	for i, step := range steps {
+		command := step.Command.ValueString()
+		if _, err := r.client.Post("/servers/"+serverID+"/command", map[string]string{"command": command}); err != nil {
+			return fmt.Errorf("step %d (%s): %w", i+1, command, err)
+		}
+		tflog.Debug(ctx, "Sent sequence command", map[string]any{"server_id": serverID, "step": i + 1})
+
+		if !step.WaitFor.IsNull() {
+			pattern := regexp.MustCompile(step.WaitFor.ValueString())
+			timeout := 60 * time.Second
+			if !step.WaitTimeout.IsNull() {
+				timeout = time.Duration(step.WaitTimeout.ValueInt64()) * time.Second
+			}
+			if _, err := waitForConsoleLine(ctx, conn, pattern, time.Now().Add(timeout)); err != nil {
+				return fmt.Errorf("step %d (%s): %w", i+1, command, err)
+			}
+		}
+
+		if delay := step.Delay.ValueInt64(); delay > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(delay) * time.Second):
+			}
+		}
+	}
+	return nil
+}
+
+// waitForConsoleLine reads the console until a line matches pattern and
+// returns that line.
+func waitForConsoleLine(ctx context.Context, conn *consoleConn, pattern *regexp.Regexp, deadline time.Time) (string, error) {
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	for {
+		lines, err := conn.read(deadline)
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return "", fmt.Errorf("no console output matched %q in time", pattern)
+		}
+		if err != nil {
+			return "", err
+		}
+		for _, line := range lines {
+			if pattern.MatchString(line) {
+				return line, nil
+			}
+		}
+	}
+}
+
+func (r *ServerCommandSequenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverCommandSequenceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := plan.ServerID.ValueString()
+	if err := r.run(ctx, serverID, plan.Steps); err != nil {
+		resp.Diagnostics.AddError("Failed to run command sequence", fmt.Sprintf("Server %s: %v", serverID, err))
+		return
+	}
+
+	plan.ID = types.StringValue(serverID + "-cmdseq")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerCommandSequenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverCommandSequenceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// No read-back — commands leave nothing to query
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update never re-runs the sequence: changing `steps` or `triggers` forces
+// replacement, so there is nothing to do beyond storing the plan.
+func (r *ServerCommandSequenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverCommandSequenceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete is a no-op – the commands have already been executed.
+func (r *ServerCommandSequenceResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &ServerDatabaseResource{}
+	_ resource.ResourceWithImportState    = &ServerDatabaseResource{}
+	_ resource.ResourceWithValidateConfig = &ServerDatabaseResource{}
+)
+
+// ServerDatabaseResource manages a database of a server as an administrator.
+type ServerDatabaseResource struct {
+	resourceBase
+}
+
+// serverDatabaseModel holds the resource state.
+type serverDatabaseModel struct {
+	ServerID       types.Int64  `tfsdk:"server_id"`
+	Database       types.String `tfsdk:"database"`
+	Remote         types.String `tfsdk:"remote"`
+	HostID         types.Int64  `tfsdk:"host_id"`
+	PreferNodeHost types.Bool   `tfsdk:"prefer_node_host"`
+	DatabaseID     types.Int64  `tfsdk:"database_id"`
+	Name           types.String `tfsdk:"name"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	Address        types.String `tfsdk:"address"`
+	ID             types.String `tfsdk:"id"` // synthetic: "<server_id>:<database_id>"
+}
+
+func NewServerDatabaseResource() resource.Resource {
+	return &ServerDatabaseResource{resourceBase: newResourceBase("kineticpanel_server_database", scopeApplication)}
+}
+
+func (r *ServerDatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_database"
+}
+
+func (r *ServerDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importComposite(ctx, req, resp, int64Part("server_id", "<server_id>"), int64Part("database_id", "<database_id>"))
+}
+
+func (r *ServerDatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a database for a server (Application API). " +
+			"The host is either given with `host_id` or, with `prefer_node_host`, the database host linked to the server's node (see `kineticpanel_database_host`), so configurations need no host ID bookkeeping. " +
+			"Every setting requires replacement, which drops the database. Destroying the resource deletes the database.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Numeric server ID.",
+			},
+			"database": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 48),
+				},
+				Description: "Database name. The panel prefixes it with `s<server_id>_`; see `name`.",
+			},
+			"remote": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Hosts the database user may connect from, in MySQL syntax. Default: `%` (anywhere).",
+			},
+			"host_id": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplaceIf(func(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.ConfigValue.IsNull()
+					}, "Moving the database to another host replaces it.", "Moving the database to another host replaces it."),
+				},
+				Description: "Database host to create the database on. Conflicts with `prefer_node_host`; when both are unset the database host linked to the server's node is used.",
+			},
+			"prefer_node_host": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Description: "Create the database on the database host linked to the server's node. Default: true when `host_id` is unset.",
+			},
+			"database_id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Database ID.",
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Full database name as created on the host.",
+			},
+			"username": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Database user.",
+			},
+			"password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Password of the database user.",
+			},
+			"address": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "`host:port` of the database host.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>:<database_id>`).",
+			},
+		},
+	}
+}
+
+func (r *ServerDatabaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config serverDatabaseModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.HostID.IsNull() && config.PreferNodeHost.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("prefer_node_host"), "Conflicting Attributes",
+			"Set either host_id or prefer_node_host = true, not both.")
+	}
+	if config.HostID.IsNull() && !config.PreferNodeHost.IsNull() && !config.PreferNodeHost.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("host_id"), "Missing Database Host",
+			"Set host_id when prefer_node_host is false.")
+	}
+}
+
+// getAppServerDatabase fetches a database of a server with its host and password.
+func getAppServerDatabase(client *Client, serverID, databaseID int64) (appServerDatabase, error) {
+	body, err := client.Get(fmt.Sprintf("/servers/%d/databases/%d?include=host,password", serverID, databaseID))
+	if err != nil {
+		return appServerDatabase{}, err
+	}
+	var apiResp struct {
+		Attributes appServerDatabase `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return appServerDatabase{}, fmt.Errorf("JSON parse error: %w", err)
+	}
+	return apiResp.Attributes, nil
+}
+
+// apply copies a database into m.
+func (m *serverDatabaseModel) apply(db appServerDatabase) {
+	m.DatabaseID = types.Int64Value(db.ID)
+	m.HostID = types.Int64Value(db.Host)
+	m.Name = types.StringValue(db.Database)
+	m.Username = types.StringValue(db.Username)
+	if p := db.Relationships.Password.Attributes.Password; p != "" {
+		m.Password = types.StringValue(p)
+	}
+	h := db.Relationships.Host.Attributes
+	if h.Host != "" {
+		m.Address = types.StringValue(fmt.Sprintf("%s:%d", h.Host, h.Port))
+	}
+	m.ID = types.StringValue(fmt.Sprintf("%d:%d", m.ServerID.ValueInt64(), db.ID))
+}
+
+func (r *ServerDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverDatabaseModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := plan.ServerID.ValueInt64()
+	hostID := plan.HostID.ValueInt64()
+	if plan.HostID.IsNull() || plan.HostID.IsUnknown() {
+		build, err := getAppServerBuild(r.client, serverID)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch server %d: %v", serverID, err))
+			return
+		}
+		host, err := nodeDatabaseHost(r.client, build.Node)
+		if err != nil {
+			resp.Diagnostics.AddError("No Database Host For Node",
+				fmt.Sprintf("Failed to pick a database host for server %d: %v. Link a host to the node with kineticpanel_database_host.node_id, or set host_id.", serverID, err))
+			return
+		}
+		hostID = host.ID
+	}
+
+	remote := "%"
+	if !plan.Remote.IsNull() {
+		remote = plan.Remote.ValueString()
+	}
+	body, err := r.client.Post(fmt.Sprintf("/servers/%d/databases", serverID), map[string]any{
+		"database": plan.Database.ValueString(),
+		"remote":   remote,
+		"host":     hostID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create database %s for server %d: %v", plan.Database.ValueString(), serverID, err))
+		return
+	}
+	var created struct {
+		Attributes appServerDatabase `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create database %s for server %d: JSON parse error: %v", plan.Database.ValueString(), serverID, err))
+		return
+	}
+
+	db, err := getAppServerDatabase(r.client, serverID, created.Attributes.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch database %d of server %d: %v", created.Attributes.ID, serverID, err))
+		return
+	}
+	plan.apply(db)
+	if plan.Password.IsUnknown() {
+		plan.Password = types.StringNull()
+	}
+	if plan.Address.IsUnknown() {
+		plan.Address = types.StringNull()
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverDatabaseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, databaseID := state.ServerID.ValueInt64(), state.DatabaseID.ValueInt64()
+	db, err := getAppServerDatabase(r.client, serverID, databaseID)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch database %d of server %d: %v", databaseID, serverID, err))
+		return
+	}
+	state.apply(db)
+	if !state.Remote.IsNull() || db.Remote != "%" {
+		state.Remote = types.StringValue(db.Remote)
+	}
+	if state.Database.IsNull() {
+		// Imported: recover the unprefixed name.
+		state.Database = types.StringValue(strings.TrimPrefix(db.Database, fmt.Sprintf("s%d_", serverID)))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement.
+	var plan serverDatabaseModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverDatabaseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, databaseID := state.ServerID.ValueInt64(), state.DatabaseID.ValueInt64()
+	if err := r.client.Delete(fmt.Sprintf("/servers/%d/databases/%d", serverID, databaseID)); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete database %d of server %d: %v", databaseID, serverID, err))
+	}
+}
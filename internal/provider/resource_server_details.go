@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ServerDetailsResource{}
+
+// ServerDetailsResource manages the name, owner and descriptive fields of an
+// existing server as an administrator.
+type ServerDetailsResource struct {
+	resourceBase
+}
+
+// serverDetailsModel holds the resource state. Unset fields keep their current value.
+type serverDetailsModel struct {
+	ServerID    types.Int64  `tfsdk:"server_id"`
+	Name        types.String `tfsdk:"name"`
+	UserID      types.Int64  `tfsdk:"user_id"`
+	ExternalID  types.String `tfsdk:"external_id"`
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"` // synthetic: "<server_id>-details"
+}
+
+func NewServerDetailsResource() resource.Resource {
+	return &ServerDetailsResource{resourceBase: newResourceBase("kineticpanel_server_details", scopeApplication)}
+}
+
+func (r *ServerDetailsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_details"
+}
+
+func (r *ServerDetailsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", "Expected the numeric server ID.")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), id)...)
+}
+
+func (r *ServerDetailsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the name, owner, external ID and description of an existing server (Application API), without importing the whole server. " +
+			"Only configured fields are managed; the rest are read back. Destroying the resource leaves the details as they are. " +
+			"Do not combine with `kineticpanel_server` for the same server, which manages the name and owner itself.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Numeric server ID.",
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 191),
+				},
+				Description: "Server name. Default: the current value.",
+			},
+			"user_id": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "ID of the user owning the server. Default: the current value.",
+			},
+			"external_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(191),
+				},
+				Description: "Identifier of the server in an external system, unique across the panel. An empty string clears it. Default: the current value.",
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Server description. Default: the current value.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-details`).",
+			},
+		},
+	}
+}
+
+// getAppServerSummary fetches a server's details from the Application API.
+func getAppServerSummary(client *Client, serverID int64) (appServerSummary, error) {
+	body, err := client.Get("/servers/" + strconv.FormatInt(serverID, 10))
+	if err != nil {
+		return appServerSummary{}, err
+	}
+	var apiResp struct {
+		Attributes appServerSummary `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return appServerSummary{}, fmt.Errorf("JSON parse error: %w", err)
+	}
+	return apiResp.Attributes, nil
+}
+
+// apply patches the configured fields onto the current details; the endpoint
+// replaces all of them, so unset ones are sent unchanged.
+func (r *ServerDetailsResource) apply(plan serverDetailsModel) error {
+	current, err := getAppServerSummary(r.client, plan.ServerID.ValueInt64())
+	if err != nil {
+		return err
+	}
+	payload := map[string]any{
+		"name":        current.Name,
+		"user":        current.User,
+		"external_id": current.ExternalID,
+		"description": current.Description,
+	}
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		payload["name"] = plan.Name.ValueString()
+	}
+	if !plan.UserID.IsNull() && !plan.UserID.IsUnknown() {
+		payload["user"] = plan.UserID.ValueInt64()
+	}
+	if !plan.ExternalID.IsNull() && !plan.ExternalID.IsUnknown() {
+		payload["external_id"] = nil
+		if v := plan.ExternalID.ValueString(); v != "" {
+			payload["external_id"] = v
+		}
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		payload["description"] = plan.Description.ValueString()
+	}
+	_, err = r.client.Patch("/servers/"+strconv.FormatInt(current.ID, 10)+"/details", payload)
+	return err
+}
+
+// read copies the panel's details into state.
+func (r *ServerDetailsResource) read(state *serverDetailsModel) error {
+	serverID := state.ServerID.ValueInt64()
+	s, err := getAppServerSummary(r.client, serverID)
+	if err != nil {
+		return err
+	}
+	externalID := ""
+	if s.ExternalID != nil {
+		externalID = *s.ExternalID
+	}
+	state.Name = types.StringValue(s.Name)
+	state.UserID = types.Int64Value(s.User)
+	state.ExternalID = types.StringValue(externalID)
+	state.Description = types.StringValue(s.Description)
+	state.ID = types.StringValue(strconv.FormatInt(serverID, 10) + "-details")
+	return nil
+}
+
+func (r *ServerDetailsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverDetailsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := plan.ServerID.ValueInt64()
+	if err := r.apply(plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update details of server %d: %v", serverID, err))
+		return
+	}
+	if err := r.read(&plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch server %d: %v", serverID, err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerDetailsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverDetailsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(&state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch server %d: %v", state.ServerID.ValueInt64(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerDetailsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverDetailsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := plan.ServerID.ValueInt64()
+	if err := r.apply(plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update details of server %d: %v", serverID, err))
+		return
+	}
+	if err := r.read(&plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch server %d: %v", serverID, err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerDetailsResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: the server keeps its current details
+	resp.State.RemoveResource(ctx)
+}
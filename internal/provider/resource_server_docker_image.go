@@ -12,11 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ resource.Resource = &ServerDockerImageResource{}
+var (
+	_ resource.Resource               = &ServerDockerImageResource{}
+	_ resource.ResourceWithModifyPlan = &ServerDockerImageResource{}
+)
 
 // ServerDockerImageResource updates the Docker image for a server.
 type ServerDockerImageResource struct {
-	client *Client
+	resourceBase
 }
 
 // dockerImageModel holds the resource state.
@@ -27,7 +30,7 @@ type dockerImageModel struct {
 }
 
 func NewServerDockerImageResource() resource.Resource {
-	return &ServerDockerImageResource{}
+	return &ServerDockerImageResource{resourceBase: newResourceBase("kineticpanel_server_docker_image", scopeClient)}
 }
 
 func (r *ServerDockerImageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -35,12 +38,12 @@ func (r *ServerDockerImageResource) Metadata(_ context.Context, req resource.Met
 }
 
 func (r *ServerDockerImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("server_id"), req, resp)
+	importComposite(ctx, req, resp, serverIDPart)
 }
 
 func (r *ServerDockerImageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Updates the Docker image used by a Kinetic Panel server (Client API).",
+		Description: "Updates the Docker image used by a Kinetic Panel server (Client API). Plan warns when the image is not one the server's egg allows.",
 		Attributes: map[string]schema.Attribute{
 			"server_id": schema.StringAttribute{
 				Required: true,
@@ -64,19 +67,32 @@ func (r *ServerDockerImageResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
-func (r *ServerDockerImageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ModifyPlan warns when a new image is not in the egg's allowed list, or with
+// verify_docker_images not in its registry.
+func (r *ServerDockerImageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
-		)
+	var plan dockerImageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ServerID.IsUnknown() || plan.DockerImage.IsUnknown() {
 		return
 	}
-	r.client = client
+	if !req.State.Raw.IsNull() {
+		var state dockerImageModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if state.DockerImage.Equal(plan.DockerImage) {
+			return
+		}
+	}
+
+	warnMissingImage(ctx, &resp.Diagnostics, r.client, path.Root("docker_image"), plan.DockerImage.ValueString())
+	allowed, err := startupDockerImages(r.client, plan.ServerID.ValueString())
+	if err != nil {
+		// The server may not exist yet; the image is checked again at apply.
+		return
+	}
+	warnUnlistedImage(&resp.Diagnostics, path.Root("docker_image"), plan.DockerImage.ValueString(), allowed, "The egg of server "+plan.ServerID.ValueString())
 }
 
 func (r *ServerDockerImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -107,7 +123,17 @@ func (r *ServerDockerImageResource) Read(ctx context.Context, req resource.ReadR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	// No read-back — use data_server_startup to verify
+	// No read-back — use data_server_startup to verify. Imports start from
+	// the current image.
+	if state.DockerImage.IsNull() {
+		server, err := getClientServer(r.client, state.ServerID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch server %s: %v", state.ServerID.ValueString(), err))
+			return
+		}
+		state.DockerImage = types.StringValue(server.DockerImage)
+		state.ID = types.StringValue(state.ServerID.ValueString() + "-docker")
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &ServerEggResource{}
+	_ resource.ResourceWithModifyPlan = &ServerEggResource{}
+)
+
+// ServerEggResource switches an existing server to another egg (Application API).
+type ServerEggResource struct {
+	resourceBase
+}
+
+// serverEggModel holds the resource state.
+type serverEggModel struct {
+	ServerID    types.Int64  `tfsdk:"server_id"` // internal numeric ID
+	NestID      types.Int64  `tfsdk:"nest_id"`
+	EggID       types.Int64  `tfsdk:"egg_id"`
+	DockerImage types.String `tfsdk:"docker_image"` // defaults to the egg's image
+	Environment types.Map    `tfsdk:"environment"`  // only the keys managed by Terraform
+	SkipScripts types.Bool   `tfsdk:"skip_scripts"`
+	Reinstall   types.Bool   `tfsdk:"reinstall"` // reinstall when the egg changes
+	Startup     types.String `tfsdk:"startup"`
+	ID          types.String `tfsdk:"id"` // synthetic: "<server_id>-egg"
+}
+
+func NewServerEggResource() resource.Resource {
+	return &ServerEggResource{resourceBase: newResourceBase("kineticpanel_server_egg", scopeApplication)}
+}
+
+func (r *ServerEggResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_egg"
+}
+
+func (r *ServerEggResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", "Expected the numeric server ID")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), id)...)
+}
+
+func (r *ServerEggResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Switches an existing Kinetic Panel server to another egg (Application API), e.g. Vanilla to Paper, without recreating it. " +
+			"The startup command and image follow the new egg, and variables the new egg defines keep their current value or fall back to the egg default. " +
+			"If the server is also managed by `kineticpanel_server`, add `egg_id` to its `lifecycle.ignore_changes`. Destroying the resource leaves the server on its current egg.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Internal numeric server ID (the `id` of `kineticpanel_server`).",
+			},
+			"nest_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Nest that contains `egg_id`.",
+			},
+			"egg_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Egg to switch the server to.",
+			},
+			"docker_image": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Docker image to run. Defaults to the egg's default image.",
+			},
+			"environment": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Values for the new egg's variables. Variables not listed keep their current value or the egg default. Drift is detected per key.",
+			},
+			"skip_scripts": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip the egg's install script on future reinstalls. Default: false.",
+			},
+			"reinstall": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Reinstall the server after switching to a different egg, so the new egg's install script runs. This wipes files the script replaces. Default: false.",
+			},
+			"startup": schema.StringAttribute{
+				Computed:    true,
+				Description: "Startup command taken from the new egg.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-egg`).",
+			},
+		},
+	}
+}
+
+// ModifyPlan checks a changed docker_image against its registry when
+// verify_docker_images is set.
+func (r *ServerEggResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var plan serverEggModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.DockerImage.IsNull() || plan.DockerImage.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state serverEggModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if state.DockerImage.Equal(plan.DockerImage) {
+			return
+		}
+	}
+	warnMissingImage(ctx, &resp.Diagnostics, r.client, path.Root("docker_image"), plan.DockerImage.ValueString())
+}
+
+// apply switches the server to the planned egg and reinstalls it when asked to
+// and the egg actually changed.
+func (r *ServerEggResource) apply(ctx context.Context, plan *serverEggModel) error {
+	serverID := plan.ServerID.ValueInt64()
+	current, err := getAppServerStartup(r.client, serverID)
+	if err != nil {
+		return fmt.Errorf("read server: %w", err)
+	}
+	egg, err := getEgg(r.client, plan.NestID.ValueInt64(), plan.EggID.ValueInt64())
+	if err != nil {
+		return fmt.Errorf("read egg %d: %w", plan.EggID.ValueInt64(), err)
+	}
+
+	overrides := map[string]string{}
+	if !plan.Environment.IsNull() && !plan.Environment.IsUnknown() {
+		if diags := plan.Environment.ElementsAs(ctx, &overrides, false); diags.HasError() {
+			return fmt.Errorf("invalid environment: %v", diags)
+		}
+	}
+
+	image := egg.defaultImage()
+	if !plan.DockerImage.IsNull() && !plan.DockerImage.IsUnknown() {
+		image = plan.DockerImage.ValueString()
+	}
+
+	env := eggEnvironment(egg, current.environment(), overrides)
+	if err := updateAppServerStartup(r.client, serverID, egg.ID, image, egg.Startup, env, plan.SkipScripts.ValueBool()); err != nil {
+		return err
+	}
+
+	if plan.Reinstall.ValueBool() && current.Egg != egg.ID {
+		tflog.Info(ctx, "Reinstalling server after egg change", map[string]any{"server_id": serverID, "egg": egg.ID})
+		if _, err := r.client.Post("/servers/"+strconv.FormatInt(serverID, 10)+"/reinstall", nil); err != nil {
+			return fmt.Errorf("reinstall: %w", err)
+		}
+	}
+
+	plan.DockerImage = types.StringValue(image)
+	plan.Startup = types.StringValue(egg.Startup)
+	plan.ID = types.StringValue(strconv.FormatInt(serverID, 10) + "-egg")
+	return nil
+}
+
+func (r *ServerEggResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverEggModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to change egg for server %d: %v", plan.ServerID.ValueInt64(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerEggResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverEggModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := getAppServerStartup(r.client, state.ServerID.ValueInt64())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read server %d: %v", state.ServerID.ValueInt64(), err))
+		return
+	}
+
+	state.NestID = types.Int64Value(current.Nest)
+	state.EggID = types.Int64Value(current.Egg)
+	state.DockerImage = types.StringValue(current.Container.Image)
+	state.Startup = types.StringValue(current.Container.StartupCommand)
+	state.ID = types.StringValue(strconv.FormatInt(state.ServerID.ValueInt64(), 10) + "-egg")
+
+	// Only report keys Terraform manages.
+	if !state.Environment.IsNull() {
+		managed := map[string]string{}
+		resp.Diagnostics.Append(state.Environment.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		env := current.environment()
+		observed := map[string]string{}
+		for k := range managed {
+			if v, ok := env[k]; ok {
+				observed[k] = v
+			}
+		}
+		m, diags := stringMapValue(observed)
+		resp.Diagnostics.Append(diags...)
+		state.Environment = m
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerEggResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverEggModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to change egg for server %d: %v", plan.ServerID.ValueInt64(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerEggResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: a server always has an egg, so it stays on the current one
+	resp.State.RemoveResource(ctx)
+}
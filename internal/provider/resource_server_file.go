@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource               = &ServerFileResource{}
+	_ resource.ResourceWithModifyPlan = &ServerFileResource{}
+)
+
+// ServerFileResource manages the contents of one file inside a server.
+type ServerFileResource struct {
+	resourceBase
+}
+
+// serverFileModel holds the resource state.
+type serverFileModel struct {
+	ServerID      types.String `tfsdk:"server_id"`
+	Path          types.String `tfsdk:"path"`
+	Content       types.String `tfsdk:"content"`        // text files
+	ContentBase64 types.String `tfsdk:"content_base64"` // binary files
+	Source        types.String `tfsdk:"source"`         // local path
+	Mode          types.String `tfsdk:"mode"`           // octal, e.g. 755
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	ID            types.String `tfsdk:"id"` // synthetic: "<server_id>-file-<path>"
+}
+
+func NewServerFileResource() resource.Resource {
+	return &ServerFileResource{resourceBase: newResourceBase("kineticpanel_server_file", scopeClient)}
+}
+
+func (r *ServerFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_file"
+}
+
+func (r *ServerFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importComposite(ctx, req, resp, serverIDPart, importIDPart{
+		Attr:  "path",
+		Label: "<path>",
+		Parse: func(s string) (any, error) { return normalizeServerPath(s), nil },
+	})
+}
+
+func (r *ServerFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages one file inside a Kinetic Panel server (Client API). Use `content` for text, `content_base64` for binary files such as icons, small jars or region files, " +
+			"and `source` to upload a local file without inlining it in the configuration. Drift is detected by comparing SHA-256 hashes, so the remote file is rewritten when it was changed outside Terraform. Destroying the resource deletes the file.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"path": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "File path relative to the server root (e.g. `config/motd.txt`). Missing directories are created.",
+			},
+			"content": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(tfpath.MatchRoot("content_base64"), tfpath.MatchRoot("source")),
+				},
+				Description: "File contents as UTF-8 text. Exactly one of `content`, `content_base64` and `source` must be set.",
+			},
+			"content_base64": schema.StringAttribute{
+				Optional:    true,
+				Description: "File contents, base64-encoded (e.g. `filebase64(\"server-icon.png\")`).",
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a local file to upload (e.g. `./configs/bukkit.yml`). Its hash is checked on every plan, so editing the local file uploads it again.",
+			},
+			"mode": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					fileModeValidator,
+				},
+				Description: "Permission bits in octal (e.g. `755` for an executable script). When omitted the panel default applies and is not managed.",
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the file contents. Known at plan time unless the contents come from another resource.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-file-<path>`).",
+			},
+		},
+	}
+}
+
+// contentBytes returns the file contents configured in m.
+func (m serverFileModel) contentBytes() ([]byte, error) {
+	if !m.Source.IsNull() {
+		return os.ReadFile(m.Source.ValueString())
+	}
+	if !m.ContentBase64.IsNull() {
+		data, err := base64.StdEncoding.DecodeString(m.ContentBase64.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("content_base64 is not valid base64: %w", err)
+		}
+		return data, nil
+	}
+	return []byte(m.Content.ValueString()), nil
+}
+
+// setContent stores data in the attribute m uses, keeping the configured
+// value when it already encodes the same bytes. With a source file only the
+// hash is stored; ModifyPlan compares it with the local file.
+func (m *serverFileModel) setContent(data []byte) {
+	sum := sha256Hex(data)
+	if m.Source.IsNull() && (sum != m.ContentSHA256.ValueString() || (m.Content.IsNull() && m.ContentBase64.IsNull())) {
+		switch {
+		case !m.ContentBase64.IsNull(), m.Content.IsNull() && !utf8.Valid(data):
+			m.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(data))
+		default:
+			// Binary data behind `content` still shows up as a diff.
+			m.Content = types.StringValue(strings.ToValidUTF8(string(data), "\uFFFD"))
+		}
+	}
+	m.ContentSHA256 = types.StringValue(sum)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// ModifyPlan computes content_sha256 from the configured contents, so that a
+// changed source file, or a remote file that drifted, plans an update.
+func (r *ServerFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan serverFileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Content.IsUnknown() || plan.ContentBase64.IsUnknown() || plan.Source.IsUnknown() {
+		return
+	}
+	data, err := plan.contentBytes()
+	if err != nil {
+		// The source may be written by another resource during the apply.
+		if !errors.Is(err, fs.ErrNotExist) {
+			resp.Diagnostics.AddError("Invalid File Contents", err.Error())
+		}
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tfpath.Root("content_sha256"), sha256Hex(data))...)
+}
+
+// serverFileSeenKey is the private state key of the file as last seen on the
+// server.
+const serverFileSeenKey = "seen"
+
+// serverFileSeen is the file as last written or read.
+type serverFileSeen struct {
+	SHA256 string `json:"sha256"`
+	Mode   string `json:"mode,omitempty"`
+}
+
+// write uploads the configured contents and sets the mode, skipping either
+// when seen says the server already has it. It returns what the server has
+// afterwards.
+func (r *ServerFileResource) write(m *serverFileModel, seen serverFileSeen) (serverFileSeen, error) {
+	data, err := m.contentBytes()
+	if err != nil {
+		return seen, err
+	}
+	sum := sha256Hex(data)
+	if sum != seen.SHA256 {
+		if err := writeServerFile(r.client, m.ServerID.ValueString(), m.Path.ValueString(), data); err != nil {
+			return seen, err
+		}
+		seen.SHA256 = sum
+	}
+	m.ContentSHA256 = types.StringValue(sum)
+	if !m.Mode.IsNull() && (seen.Mode == "" || !sameFileMode(m.Mode.ValueString(), seen.Mode)) {
+		if err := chmodServerFile(r.client, m.ServerID.ValueString(), m.Path.ValueString(), m.Mode.ValueString()); err != nil {
+			return seen, err
+		}
+		seen.Mode = m.Mode.ValueString()
+	}
+	return seen, nil
+}
+
+func (r *ServerFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverFileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := plan.ServerID.ValueString()
+	seen, err := r.write(&plan, serverFileSeen{})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to write %s on server %s: %v", plan.Path.ValueString(), serverID, err))
+		return
+	}
+
+	plan.ID = types.StringValue(serverID + "-file-" + normalizeServerPath(plan.Path.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setPrivateJSON(ctx, resp.Private, serverFileSeenKey, seen)...)
+}
+
+func (r *ServerFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverFileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, file := state.ServerID.ValueString(), state.Path.ValueString()
+	data, err := readServerFileLimited(r.client, serverID, file, maxResponseBytes)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read %s on server %s: %v", file, serverID, err))
+		return
+	}
+
+	state.setContent(data)
+	seen := serverFileSeen{SHA256: state.ContentSHA256.ValueString()}
+	if !state.Mode.IsNull() {
+		info, err := statServerFile(r.client, serverID, file)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to stat %s on server %s: %v", file, serverID, err))
+			return
+		}
+		if !sameFileMode(state.Mode.ValueString(), info.ModeBits) {
+			state.Mode = types.StringValue(info.ModeBits)
+		}
+		seen.Mode = info.ModeBits
+	}
+	state.ID = types.StringValue(serverID + "-file-" + normalizeServerPath(file))
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setPrivateJSON(ctx, resp.Private, serverFileSeenKey, seen)...)
+}
+
+func (r *ServerFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverFileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing only the mode, or content the server already has, skips the upload.
+	var seen serverFileSeen
+	getPrivateJSON(ctx, req.Private, serverFileSeenKey, &seen)
+	seen, err := r.write(&plan, seen)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to write %s on server %s: %v", plan.Path.ValueString(), plan.ServerID.ValueString(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setPrivateJSON(ctx, resp.Private, serverFileSeenKey, seen)...)
+}
+
+func (r *ServerFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverFileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := state.ServerID.ValueString()
+	dir, name := path.Split(normalizeServerPath(state.Path.ValueString()))
+	if err := deleteServerFiles(r.client, serverID, dir, name); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete %s on server %s: %v", state.Path.ValueString(), serverID, err))
+	}
+}
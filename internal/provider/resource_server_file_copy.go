@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ServerFileCopyResource{}
+
+// ServerFileCopyResource duplicates a file next to the original.
+type ServerFileCopyResource struct {
+	resourceBase
+}
+
+// fileCopyModel holds the resource state.
+type fileCopyModel struct {
+	ServerID types.String `tfsdk:"server_id"`
+	Path     types.String `tfsdk:"path"`
+	Triggers types.Map    `tfsdk:"triggers"`  // copy again when changed
+	CopyPath types.String `tfsdk:"copy_path"` // as named by the panel
+	ID       types.String `tfsdk:"id"`        // synthetic: "<server_id>-copy-<path>"
+}
+
+func NewServerFileCopyResource() resource.Resource {
+	return &ServerFileCopyResource{resourceBase: newResourceBase("kineticpanel_server_file_copy", scopeClient)}
+}
+
+func (r *ServerFileCopyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_file_copy"
+}
+
+func (r *ServerFileCopyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Copies a file inside a Kinetic Panel server (Client API), e.g. to keep a snapshot of a config before a `kineticpanel_server_file` overwrites it (order them with `depends_on`). " +
+			"The panel names the copy (`bukkit copy.yml`, `bukkit copy 1.yml`, ...). The copy runs on create; changing any argument replaces the resource, which copies again. " +
+			"Destroying the resource leaves the copies in place.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"path": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "File to copy, relative to the server root (e.g. `plugins/Essentials/config.yml`).",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that re-fire the copy when changed (e.g. the hash of the content about to be written).",
+			},
+			"copy_path": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Path of the copy, or null if the panel's listing did not show a new file.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-copy-<path>`).",
+			},
+		},
+	}
+}
+
+func (r *ServerFileCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan fileCopyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, file := plan.ServerID.ValueString(), normalizeServerPath(plan.Path.ValueString())
+	copyPath, err := copyServerFile(r.client, serverID, file)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to copy %s on server %s: %v", file, serverID, err))
+		return
+	}
+
+	plan.CopyPath = types.StringNull()
+	if copyPath != "" {
+		plan.CopyPath = types.StringValue(copyPath)
+	}
+	plan.ID = types.StringValue(serverID + "-copy-" + file)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerFileCopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state fileCopyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// No read-back — the copy is a one-time action; copying again would not
+	// restore the snapshot
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerFileCopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan fileCopyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every argument forces replacement, so there is nothing to apply here.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerFileCopyResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: the copies are snapshots that outlive the resource
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ServerFileDeleteResource{}
+
+// ServerFileDeleteResource deletes a list of files and directories from a server.
+type ServerFileDeleteResource struct {
+	resourceBase
+}
+
+// fileDeleteModel holds the resource state.
+type fileDeleteModel struct {
+	ServerID types.String `tfsdk:"server_id"`
+	Paths    types.List   `tfsdk:"paths"`
+	Triggers types.Map    `tfsdk:"triggers"` // delete again when changed
+	ID       types.String `tfsdk:"id"`       // synthetic: "<server_id>-delete"
+}
+
+func NewServerFileDeleteResource() resource.Resource {
+	return &ServerFileDeleteResource{resourceBase: newResourceBase("kineticpanel_server_file_delete", scopeClient)}
+}
+
+func (r *ServerFileDeleteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_file_delete"
+}
+
+func (r *ServerFileDeleteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Deletes files and directories inside a Kinetic Panel server (Client API), for cleanup steps such as purging old logs or crash reports during a maintenance apply. " +
+			"Paths that do not exist are skipped. The deletion runs on create; changing any argument replaces the resource, which deletes again. Destroying the resource does nothing.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"paths": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				Description: "Files or directories to delete, relative to the server root (e.g. `logs`, `crash-reports`). Directories are deleted with their contents.",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that re-fire the deletion when changed (e.g. a maintenance window date).",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-delete`).",
+			},
+		},
+	}
+}
+
+func (r *ServerFileDeleteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan fileDeleteModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var paths []string
+	resp.Diagnostics.Append(plan.Paths.ElementsAs(ctx, &paths, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The delete endpoint takes one root directory per call.
+	serverID := plan.ServerID.ValueString()
+	byDir := map[string][]string{}
+	var dirs []string
+	for _, p := range paths {
+		dir, name := path.Split(strings.TrimRight(normalizeServerPath(p), "/"))
+		if name == "" {
+			resp.Diagnostics.AddError("Invalid Path", "Refusing to delete the server root.")
+			return
+		}
+		if _, ok := byDir[dir]; !ok {
+			dirs = append(dirs, dir)
+		}
+		byDir[dir] = append(byDir[dir], name)
+	}
+	for _, dir := range dirs {
+		if err := deleteServerFiles(r.client, serverID, dir, byDir[dir]...); err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete %s in %s on server %s: %v", strings.Join(byDir[dir], ", "), dir, serverID, err))
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(serverID + "-delete")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerFileDeleteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state fileDeleteModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// No read-back — the deletion is a one-time action
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerFileDeleteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan fileDeleteModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every argument forces replacement, so there is nothing to apply here.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerFileDeleteResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: deleted files cannot be restored
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ServerFileUploadResource{}
+
+// ServerFileUploadResource uploads a local file (e.g. a world or modpack archive) to a server.
+type ServerFileUploadResource struct {
+	resourceBase
+}
+
+// fileUploadModel holds the resource state.
+type fileUploadModel struct {
+	ServerID              types.String `tfsdk:"server_id"`
+	Source                types.String `tfsdk:"source"`      // local path
+	SourceHash            types.String `tfsdk:"source_hash"` // re-upload when changed
+	Directory             types.String `tfsdk:"directory"`   // defaults to /
+	Decompress            types.Bool   `tfsdk:"decompress"`
+	DeleteAfterDecompress types.Bool   `tfsdk:"delete_after_decompress"`
+	Retries               types.Int64  `tfsdk:"retries"` // default 3
+	Mode                  types.String `tfsdk:"mode"`    // octal, e.g. 755
+	SizeBytes             types.Int64  `tfsdk:"size_bytes"`
+	ID                    types.String `tfsdk:"id"` // synthetic: "<server_id>-upload-<directory>/<file>"
+}
+
+func NewServerFileUploadResource() resource.Resource {
+	return &ServerFileUploadResource{resourceBase: newResourceBase("kineticpanel_server_file_upload", scopeClient)}
+}
+
+func (r *ServerFileUploadResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_file_upload"
+}
+
+func (r *ServerFileUploadResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads a local file to a Kinetic Panel server through a signed upload URL on the node (Client API), optionally extracting it afterwards. " +
+			"Use it to seed worlds or modpacks in the same apply that creates the server. The file is streamed and uploads failing with a transient error are retried; the node's upload size limit still applies. " +
+			"Changing any input uploads again. Destroying the resource leaves the uploaded files in place.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"source": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Path of the local file to upload. The uploaded file keeps its base name.",
+			},
+			"source_hash": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Hash of the local file (e.g. `filesha256(\"world.zip\")`). Set it so that changing the file uploads it again.",
+			},
+			"directory": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Directory inside the server volume to upload into. Default: `/`.",
+			},
+			"decompress": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Description: "Extract the archive into `directory` after uploading. Default: false.",
+			},
+			"delete_after_decompress": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Description: "Delete the archive once it has been extracted. Default: false.",
+			},
+			"retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How many times to retry an upload that failed with a transient error, such as a timeout or a 502 from the node. Default: 3.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					fileModeValidator,
+					stringvalidator.ConflictsWith(path.MatchRoot("decompress")),
+				},
+				Description: "Permission bits of the uploaded file in octal (e.g. `755` for a start script). Changing it does not upload again.",
+			},
+			"size_bytes": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Size of the uploaded file.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-upload-<path>`).",
+			},
+		},
+	}
+}
+
+func uploadDirectory(m fileUploadModel) string {
+	if m.Directory.IsNull() || m.Directory.ValueString() == "" {
+		return "/"
+	}
+	return normalizeServerPath(m.Directory.ValueString())
+}
+
+func (r *ServerFileUploadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan fileUploadModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := plan.ServerID.ValueString()
+	source := plan.Source.ValueString()
+	directory := uploadDirectory(plan)
+	name := filepath.Base(source)
+
+	info, err := os.Stat(source)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Source", err.Error())
+		return
+	}
+	if info.IsDir() {
+		resp.Diagnostics.AddError("Invalid Source", fmt.Sprintf("%s is a directory; upload an archive of it instead", source))
+		return
+	}
+
+	retries := 3
+	if !plan.Retries.IsNull() {
+		retries = int(plan.Retries.ValueInt64())
+	}
+	tflog.Info(ctx, "Uploading file", map[string]any{"server_id": serverID, "source": source, "bytes": info.Size()})
+	if err := uploadServerFile(ctx, r.client, serverID, directory, source, retries); err != nil {
+		resp.Diagnostics.AddError("Upload Failed", fmt.Sprintf("Failed to upload %s to server %s: %v", source, serverID, err))
+		return
+	}
+
+	if !plan.Mode.IsNull() {
+		if err := chmodServerFile(r.client, serverID, strings.TrimRight(directory, "/")+"/"+name, plan.Mode.ValueString()); err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to change mode of %s on server %s: %v", name, serverID, err))
+			return
+		}
+	}
+
+	if plan.Decompress.ValueBool() {
+		if err := decompressServerFile(r.client, serverID, directory, name); err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to decompress %s on server %s: %v", name, serverID, err))
+			return
+		}
+		if plan.DeleteAfterDecompress.ValueBool() {
+			if err := deleteServerFiles(r.client, serverID, directory, name); err != nil {
+				resp.Diagnostics.AddWarning("Archive Not Deleted", fmt.Sprintf("Failed to delete %s on server %s after extracting it: %v", name, serverID, err))
+			}
+		}
+	}
+
+	plan.SizeBytes = types.Int64Value(info.Size())
+	plan.ID = types.StringValue(serverID + "-upload-" + strings.TrimRight(directory, "/") + "/" + name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerFileUploadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state fileUploadModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An extracted and deleted archive leaves nothing to check.
+	if state.Decompress.ValueBool() && state.DeleteAfterDecompress.ValueBool() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+
+	name := filepath.Base(state.Source.ValueString())
+	objects, err := listServerDirectory(r.client, state.ServerID.ValueString(), uploadDirectory(state))
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list files for server %s: %v", state.ServerID.ValueString(), err))
+		return
+	}
+	for _, o := range objects {
+		if o.Name == name && o.IsFile {
+			if !state.Mode.IsNull() && !sameFileMode(state.Mode.ValueString(), o.ModeBits) {
+				state.Mode = types.StringValue(o.ModeBits)
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+	}
+	// The uploaded file is gone; plan a new upload.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ServerFileUploadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only retries and mode can change in place; retries matters on create only.
+	var plan, state fileUploadModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.Mode.IsNull() && !sameFileMode(plan.Mode.ValueString(), state.Mode.ValueString()) {
+		serverID := plan.ServerID.ValueString()
+		name := filepath.Base(plan.Source.ValueString())
+		if err := chmodServerFile(r.client, serverID, strings.TrimRight(uploadDirectory(plan), "/")+"/"+name, plan.Mode.ValueString()); err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to change mode of %s on server %s: %v", name, serverID, err))
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerFileUploadResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: uploaded and extracted data belongs to the server now
+	resp.State.RemoveResource(ctx)
+}
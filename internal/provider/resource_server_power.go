@@ -2,31 +2,48 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &ServerPowerResource{}
 
 type ServerPowerResource struct {
-	client *Client
+	resourceBase
 }
 
 type serverPowerModel struct {
 	ServerID types.String `tfsdk:"server_id"`
 	Signal   types.String `tfsdk:"signal"`
+	Triggers types.Map    `tfsdk:"triggers"`
 	ID       types.String `tfsdk:"id"`
 }
 
+// powerSignals are the signals accepted by the power endpoint.
+var powerSignals = []string{"start", "stop", "restart", "kill"}
+
+// signalStates lists the power states a server may be in after a signal,
+// including the transition towards it. A restart passes through offline
+// between stopping and starting.
+var signalStates = map[string][]string{
+	"start":   {"running", "starting"},
+	"restart": {"running", "starting", "stopping", "offline"},
+	"stop":    {"offline", "stopping"},
+	"kill":    {"offline", "stopping"},
+}
+
 func NewServerPowerResource() resource.Resource {
-	return &ServerPowerResource{}
+	return &ServerPowerResource{resourceBase: newResourceBase("kineticpanel_server_power", scopeClient)}
 }
 
 func (r *ServerPowerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -34,12 +51,25 @@ func (r *ServerPowerResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 func (r *ServerPowerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("server_id"), req, resp)
+	// The signal cannot be read back, so it is part of the ID.
+	importComposite(ctx, req, resp, serverIDPart, importIDPart{
+		Attr:  "signal",
+		Label: "<signal>",
+		Parse: func(s string) (any, error) {
+			if !slices.Contains(powerSignals, s) {
+				return nil, fmt.Errorf("%q is not one of start, stop, restart, kill", s)
+			}
+			return s, nil
+		},
+	})
 }
 
 func (r *ServerPowerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Sends a power signal to a Kinetic Panel server (Client API).",
+		Description: "Sends a power signal to a Kinetic Panel server (Client API). The signal is sent on create; changing `signal` or `triggers` replaces the resource, which sends it again. " +
+			"On refresh the server's power state is compared with the signal: a server that is offline after `start`, or running after `stop` or `kill`, is removed from state, so `terraform apply` plans a create, which sends the signal again. " +
+			"A server may be in any state during a restart, so `restart` is not sent again this way. " +
+			"Destroying the resource sends nothing.",
 		Attributes: map[string]schema.Attribute{
 			"server_id": schema.StringAttribute{
 				Required: true,
@@ -51,10 +81,21 @@ func (r *ServerPowerResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"signal": schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("start", "stop", "restart", "kill"),
+					stringvalidator.OneOf(powerSignals...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
 				},
 				Description: "Power action to perform.",
 			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that re-fire the signal when changed (e.g. a timestamp or a hash of a config file).",
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -65,18 +106,6 @@ func (r *ServerPowerResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
-func (r *ServerPowerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(*Client)
-	if !ok {
-		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", "Expected *Client")
-		return
-	}
-	r.client = client
-}
-
 func (r *ServerPowerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan serverPowerModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -98,27 +127,48 @@ func (r *ServerPowerResource) Create(ctx context.Context, req resource.CreateReq
 func (r *ServerPowerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state serverPowerModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := state.ServerID.ValueString()
+	status, err := fetchServerStatus(r.client, serverID)
+	switch {
+	case isNotFound(err):
+		resp.State.RemoveResource(ctx)
+		return
+	case isConflict(err):
+		// Installing or suspended: there is no power state to compare yet
+	case err != nil:
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch status for server %s: %v", serverID, err))
+		return
+	default:
+		signal := state.Signal.ValueString()
+		if !slices.Contains(signalStates[signal], status.CurrentState) {
+			// Removed from state, the resource is planned for a plain create, which sends the signal again
+			tflog.Info(ctx, "Server power state diverged from signal", map[string]any{
+				"server_id": serverID,
+				"signal":    signal,
+				"state":     status.CurrentState,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
+// Update never re-sends the signal: every user-settable attribute forces
+// replacement, so there is nothing to do beyond storing the plan.
 func (r *ServerPowerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan serverPowerModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	pth := "/servers/" + plan.ServerID.ValueString() + "/power"
-	_, err := r.client.Post(pth, map[string]string{"signal": plan.Signal.ValueString()})
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to update power signal", err.Error())
-		return
-	}
-
-	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-power")
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
+// Delete only forgets the action; a power signal cannot be undone.
 func (r *ServerPowerResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
-	// No-op
 }
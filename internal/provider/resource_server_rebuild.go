@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ServerRebuildResource{}
+
+// ServerRebuildResource recreates a server's container without touching its data.
+type ServerRebuildResource struct {
+	resourceBase
+}
+
+// rebuildModel holds the resource state.
+type rebuildModel struct {
+	ServerID types.Int64  `tfsdk:"server_id"`
+	Triggers types.Map    `tfsdk:"triggers"` // rebuild again when changed
+	ID       types.String `tfsdk:"id"`       // synthetic: "<server_id>-rebuild"
+}
+
+func NewServerRebuildResource() resource.Resource {
+	return &ServerRebuildResource{resourceBase: newResourceBase("kineticpanel_server_rebuild", scopeApplication)}
+}
+
+func (r *ServerRebuildResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_rebuild"
+}
+
+func (r *ServerRebuildResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rebuilds a server's container (Application API) so changed limits or a new docker image take effect; server files are kept. " +
+			"The rebuild runs on create; changing `triggers` replaces the resource, which rebuilds again. Destroying the resource does nothing.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Numeric server ID.",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that re-fire the rebuild when changed (e.g. the server's memory limit and docker image).",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-rebuild`).",
+			},
+		},
+	}
+}
+
+func (r *ServerRebuildResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan rebuildModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := strconv.FormatInt(plan.ServerID.ValueInt64(), 10)
+	if _, err := r.client.Post("/servers/"+serverID+"/rebuild", nil); err != nil {
+		detail := err.Error()
+		if isNotFound(err) {
+			detail += "\n\nEither the server does not exist or this panel has no rebuild endpoint; restarting the server also recreates its container on most panels."
+		}
+		resp.Diagnostics.AddError("Failed to trigger rebuild", detail)
+		return
+	}
+
+	plan.ID = types.StringValue(serverID + "-rebuild")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerRebuildResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state rebuildModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// No read-back — rebuild is a one-time action
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerRebuildResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan rebuildModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every argument forces replacement, so there is nothing to apply here.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerRebuildResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: rebuild cannot be undone
+	resp.State.RemoveResource(ctx)
+}
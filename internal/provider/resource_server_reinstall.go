@@ -3,31 +3,44 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &ServerReinstallResource{}
 
 // ServerReinstallResource triggers a server reinstall (wipe + redeploy).
 type ServerReinstallResource struct {
-	client *Client
+	resourceBase
 }
 
 // reinstallModel holds the resource state.
 type reinstallModel struct {
-	ServerID types.String `tfsdk:"server_id"`
-	Force    types.Bool   `tfsdk:"force"` // bypass confirmation if supported
-	ID       types.String `tfsdk:"id"`    // synthetic: "<server_id>-reinstall"
+	ServerID     types.String `tfsdk:"server_id"`
+	Force        types.Bool   `tfsdk:"force"`    // bypass confirmation if supported
+	Triggers     types.Map    `tfsdk:"triggers"` // reinstall again when changed
+	Wait         types.Bool   `tfsdk:"wait"`
+	Timeout      types.Int64  `tfsdk:"timeout_seconds"`
+	PostFiles    types.Map    `tfsdk:"post_files"`    // path -> content
+	PostCommands types.List   `tfsdk:"post_commands"` // sent once running
+	ID           types.String `tfsdk:"id"`            // synthetic: "<server_id>-reinstall"
 }
 
 func NewServerReinstallResource() resource.Resource {
-	return &ServerReinstallResource{}
+	return &ServerReinstallResource{resourceBase: newResourceBase("kineticpanel_server_reinstall", scopeClient)}
 }
 
 func (r *ServerReinstallResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -35,12 +48,14 @@ func (r *ServerReinstallResource) Metadata(_ context.Context, req resource.Metad
 }
 
 func (r *ServerReinstallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("server_id"), req, resp)
+	importComposite(ctx, req, resp, serverIDPart)
 }
 
 func (r *ServerReinstallResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Reinstalls a Kinetic Panel server (wipes data and redeploys).",
+		Description: "Reinstalls a Kinetic Panel server (wipes data and redeploys). The reinstall runs on create; changing `triggers` replaces the resource, which reinstalls again. " +
+			"With `post_files` or `post_commands` the resource waits for the install to finish, writes the files, then starts the server and sends the commands, so the server ends up configured rather than at the egg's defaults. " +
+			"Changing the hooks alone does not reinstall. Destroying the resource does nothing.",
 		Attributes: map[string]schema.Attribute{
 			"server_id": schema.StringAttribute{
 				Required: true,
@@ -51,7 +66,40 @@ func (r *ServerReinstallResource) Schema(_ context.Context, _ resource.SchemaReq
 			},
 			"force": schema.BoolAttribute{
 				Optional:    true,
-				Description: "Bypass confirmation if the panel supports it. Default: false.",
+				Description: "Bypass confirmation if the panel supports it. Default: false. Changing it does not reinstall.",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that re-fire the reinstall when changed (e.g. a timestamp or a hash of a config file).",
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait until the install script has finished. Default: false, or true when `post_files` or `post_commands` are set.",
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(30, 7200),
+				},
+				Description: "How long to wait for the install, and then for the server to start before `post_commands`. Default: 1800.",
+			},
+			"post_files": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Files to write once the install has finished, keyed by path (e.g. `/server.properties`). Written before `post_commands`.",
+			},
+			"post_commands": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				Description: "Console commands to send, in order, once the install has finished. The server is started and awaited first, since commands need a running server.",
 			},
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -64,21 +112,6 @@ func (r *ServerReinstallResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
-func (r *ServerReinstallResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(*Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
-		)
-		return
-	}
-	r.client = client
-}
-
 func (r *ServerReinstallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan reinstallModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -97,11 +130,69 @@ func (r *ServerReinstallResource) Create(ctx context.Context, req resource.Creat
 		resp.Diagnostics.AddError("Failed to trigger reinstall", err.Error())
 		return
 	}
+	if err := r.postReinstall(ctx, plan); err != nil {
+		resp.Diagnostics.AddError("Post-Reinstall Hook Failed",
+			fmt.Sprintf("Server %s was reinstalled, but: %v. The resource is not saved, so the next apply reinstalls again.", plan.ServerID.ValueString(), err))
+		return
+	}
 
 	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-reinstall")
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
+// postReinstall waits for the install to finish if requested, then runs the
+// hooks: files first, so commands see them.
+func (r *ServerReinstallResource) postReinstall(ctx context.Context, plan reinstallModel) error {
+	hooks := !plan.PostFiles.IsNull() || !plan.PostCommands.IsNull()
+	if !hooks && !plan.Wait.ValueBool() {
+		return nil
+	}
+	serverID := plan.ServerID.ValueString()
+	timeout := 1800 * time.Second
+	if !plan.Timeout.IsNull() {
+		timeout = time.Duration(plan.Timeout.ValueInt64()) * time.Second
+	}
+
+	if err := waitForClientInstall(ctx, r.client, serverID, timeout); err != nil {
+		return err
+	}
+
+	files := map[string]string{}
+	if !plan.PostFiles.IsNull() {
+		if diags := plan.PostFiles.ElementsAs(ctx, &files, false); diags.HasError() {
+			return fmt.Errorf("read post_files")
+		}
+	}
+	for _, file := range slices.Sorted(maps.Keys(files)) {
+		if err := writeServerFile(r.client, serverID, file, []byte(files[file])); err != nil {
+			return fmt.Errorf("write %s: %w", file, err)
+		}
+	}
+
+	var commands []string
+	if !plan.PostCommands.IsNull() {
+		if diags := plan.PostCommands.ElementsAs(ctx, &commands, false); diags.HasError() {
+			return fmt.Errorf("read post_commands")
+		}
+	}
+	if len(commands) == 0 {
+		return nil
+	}
+	if _, err := r.client.Post("/servers/"+serverID+"/power", map[string]string{"signal": "start"}); err != nil {
+		return fmt.Errorf("start server: %w", err)
+	}
+	if err := waitForClientState(ctx, r.client, serverID, "running", timeout, 5*time.Second); err != nil {
+		return err
+	}
+	for i, command := range commands {
+		tflog.Debug(ctx, "Sending post-reinstall command", map[string]any{"server_id": serverID, "index": i})
+		if _, err := r.client.Post("/servers/"+serverID+"/command", map[string]string{"command": command}); err != nil {
+			return fmt.Errorf("send command %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
 func (r *ServerReinstallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state reinstallModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -119,20 +210,8 @@ func (r *ServerReinstallResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	// Reinstall again if force changes or re-applied
-	pth := "/servers/" + plan.ServerID.ValueString() + "/settings/reinstall"
-	payload := map[string]bool{}
-	if !plan.Force.IsNull() {
-		payload["force"] = plan.Force.ValueBool()
-	}
-
-	_, err := r.client.Post(pth, payload)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to update reinstall", err.Error())
-		return
-	}
-
-	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-reinstall")
+	// Everything but `triggers` changes in place and only takes effect on
+	// the next reinstall; use `triggers` to reinstall again.
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
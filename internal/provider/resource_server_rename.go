@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -16,7 +15,7 @@ var _ resource.Resource = &ServerRenameResource{}
 
 // ServerRenameResource updates a server's name and description.
 type ServerRenameResource struct {
-	client *Client
+	resourceBase
 }
 
 // renameModel holds the resource state.
@@ -28,7 +27,7 @@ type renameModel struct {
 }
 
 func NewServerRenameResource() resource.Resource {
-	return &ServerRenameResource{}
+	return &ServerRenameResource{resourceBase: newResourceBase("kineticpanel_server_rename", scopeClient)}
 }
 
 func (r *ServerRenameResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -36,7 +35,7 @@ func (r *ServerRenameResource) Metadata(_ context.Context, req resource.Metadata
 }
 
 func (r *ServerRenameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("server_id"), req, resp)
+	importComposite(ctx, req, resp, serverIDPart)
 }
 
 func (r *ServerRenameResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -69,21 +68,6 @@ func (r *ServerRenameResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
-func (r *ServerRenameResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(*Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
-		)
-		return
-	}
-	r.client = client
-}
-
 func (r *ServerRenameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan renameModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -115,7 +99,20 @@ func (r *ServerRenameResource) Read(ctx context.Context, req resource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	// Read just returns stored values — actual name is in data_server
+	// Read just returns stored values — actual name is in data_server.
+	// Imports start from the current name and description.
+	if state.Name.IsNull() {
+		server, err := getClientServer(r.client, state.ServerID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch server %s: %v", state.ServerID.ValueString(), err))
+			return
+		}
+		state.Name = types.StringValue(server.Name)
+		if server.Description != "" {
+			state.Description = types.StringValue(server.Description)
+		}
+		state.ID = types.StringValue(state.ServerID.ValueString() + "-rename")
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -0,0 +1,372 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &ServerRestartScheduleResource{}
+	_ resource.ResourceWithModifyPlan = &ServerRestartScheduleResource{}
+)
+
+// ServerRestartScheduleResource manages a schedule that restarts a server, with optional in-game warnings.
+type ServerRestartScheduleResource struct {
+	resourceBase
+}
+
+// restartScheduleModel holds the resource state.
+type restartScheduleModel struct {
+	ServerID       types.String `tfsdk:"server_id"`
+	Name           types.String `tfsdk:"name"`
+	Cron           types.String `tfsdk:"cron"` // e.g. "0 4 * * *"
+	IsActive       types.Bool   `tfsdk:"is_active"`
+	OnlyWhenOnline types.Bool   `tfsdk:"only_when_online"`
+	WarningMinutes types.Set    `tfsdk:"warning_minutes"` // minutes before the restart, e.g. [10, 5, 1]
+	WarningCommand types.String `tfsdk:"warning_command"` // "{minutes}" is replaced
+	ExecuteNow     types.String `tfsdk:"execute_now"`     // run the schedule when changed
+	ScheduleID     types.Int64  `tfsdk:"schedule_id"`
+	NextRunAt      types.String `tfsdk:"next_run_at"`
+	ID             types.String `tfsdk:"id"` // synthetic: "<server_id>-schedule-<schedule_id>"
+}
+
+func NewServerRestartScheduleResource() resource.Resource {
+	return &ServerRestartScheduleResource{resourceBase: newResourceBase("kineticpanel_server_restart_schedule", scopeClient)}
+}
+
+func (r *ServerRestartScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_restart_schedule"
+}
+
+func (r *ServerRestartScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importComposite(ctx, req, resp, serverIDPart, int64Part("schedule_id", "<schedule_id>"))
+}
+
+func (r *ServerRestartScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a schedule that restarts a Kinetic Panel server on a cron expression (Client API), optionally sending console commands (e.g. `say`) to warn players first. " +
+			"The first warning is sent at the cron time and the restart follows the largest `warning_minutes` value later; without warnings the restart runs at the cron time. " +
+			"Tasks changed in the panel are rebuilt from the configuration on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schedule name shown in the panel. Default: `Automatic restart`.",
+			},
+			"cron": schema.StringAttribute{
+				Required:    true,
+				Description: "Cron expression with five fields: minute, hour, day of month, month, day of week (e.g. `50 3 * * *` for a 04:00 restart with a 10 minute warning). Times are in the panel's timezone.",
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the schedule runs. Default: true.",
+			},
+			"only_when_online": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only run while the server is online, so a stopped server is not started by the restart. Default: true.",
+			},
+			"warning_minutes": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Send `warning_command` this many minutes before the restart, once per value. Consecutive warnings may be at most 15 minutes apart, and the last one at most 15 minutes before the restart.",
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+			"warning_command": schema.StringAttribute{
+				Optional:    true,
+				Description: "Console command sent for each warning; `{minutes}` is replaced with the minutes left. Default: `say Server restarting in {minutes} minute(s)`.",
+			},
+			"execute_now": schema.StringAttribute{
+				Optional:    true,
+				Description: "Run the schedule once right away whenever this value is set or changed (e.g. a timestamp), regardless of `cron` and `is_active`. Useful to test a new schedule.",
+			},
+			"schedule_id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "ID of the underlying schedule.",
+			},
+			"next_run_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the schedule runs next, as reported by the panel.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-schedule-<schedule_id>`).",
+			},
+		},
+	}
+}
+
+// restartScheduleDriftKey is the private state key Read sets when the
+// panel's tasks differ from the ones apply writes.
+const restartScheduleDriftKey = "tasks_drifted"
+
+// ModifyPlan plans an update whenever the last refresh found tasks that
+// differ from the configuration, even when warning_minutes reads back the
+// same, e.g. after a warning's text was edited or the restart task deleted.
+func (r *ServerRestartScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var drifted bool
+	if !getPrivateJSON(ctx, req.Private, restartScheduleDriftKey, &drifted) || !drifted {
+		return
+	}
+	resp.Diagnostics.AddWarning("Restart Schedule Tasks Changed",
+		"The schedule's tasks were changed outside Terraform; apply rebuilds them from the configuration.")
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_run_at"), types.StringUnknown())...)
+}
+
+// spec builds the schedule and its warning + restart tasks from the model.
+func (r *ServerRestartScheduleResource) spec(ctx context.Context, m restartScheduleModel) (scheduleSpec, []scheduleTask, error) {
+	name := "Automatic restart"
+	if !m.Name.IsNull() {
+		name = m.Name.ValueString()
+	}
+	isActive := true
+	if !m.IsActive.IsNull() {
+		isActive = m.IsActive.ValueBool()
+	}
+	onlyWhenOnline := true
+	if !m.OnlyWhenOnline.IsNull() {
+		onlyWhenOnline = m.OnlyWhenOnline.ValueBool()
+	}
+
+	spec := scheduleSpec{
+		Name:           name,
+		Cron:           m.Cron.ValueString(),
+		IsActive:       isActive,
+		OnlyWhenOnline: onlyWhenOnline,
+	}
+	tasks, err := restartTasks(ctx, m)
+	return spec, tasks, err
+}
+
+// restartTasks turns the warnings into command tasks, largest first, each
+// offset from the previous one, followed by the restart itself.
+func restartTasks(ctx context.Context, m restartScheduleModel) ([]scheduleTask, error) {
+	var minutes []int64
+	if !m.WarningMinutes.IsNull() {
+		if diags := m.WarningMinutes.ElementsAs(ctx, &minutes, false); diags.HasError() {
+			return nil, fmt.Errorf("invalid warning_minutes: %v", diags)
+		}
+	}
+	sort.Slice(minutes, func(i, j int) bool { return minutes[i] > minutes[j] })
+
+	command := "say Server restarting in {minutes} minute(s)"
+	if !m.WarningCommand.IsNull() {
+		command = m.WarningCommand.ValueString()
+	}
+
+	var tasks []scheduleTask
+	var previous int64
+	for i, left := range minutes {
+		var offset int64
+		if i > 0 {
+			offset = (previous - left) * 60
+		}
+		if offset > maxTaskOffset {
+			return nil, fmt.Errorf("warnings at %d and %d minutes are more than 15 minutes apart", previous, left)
+		}
+		tasks = append(tasks, scheduleTask{
+			Action:            "command",
+			Payload:           strings.ReplaceAll(command, "{minutes}", strconv.FormatInt(left, 10)),
+			TimeOffset:        offset,
+			ContinueOnFailure: true, // a failed warning must not cancel the restart
+		})
+		previous = left
+	}
+	if previous*60 > maxTaskOffset {
+		return nil, fmt.Errorf("the last warning (%d minutes) must be at most 15 minutes before the restart", previous)
+	}
+	tasks = append(tasks, scheduleTask{Action: "power", Payload: "restart", TimeOffset: previous * 60})
+	return tasks, nil
+}
+
+// warningMinutesFromTasks recovers warning_minutes from the schedule's tasks.
+func warningMinutesFromTasks(s scheduleAttributes) []int64 {
+	data := s.Relationships.Tasks.Data
+	var total int64
+	elapsed := make([]int64, len(data))
+	for i, t := range data {
+		total += t.Attributes.TimeOffset
+		elapsed[i] = total
+	}
+	var minutes []int64
+	for i, t := range data {
+		if t.Attributes.Action == "power" && t.Attributes.Payload == "restart" {
+			for j := 0; j < i; j++ {
+				if data[j].Attributes.Action == "command" {
+					minutes = append(minutes, (elapsed[i]-elapsed[j])/60)
+				}
+			}
+			break
+		}
+	}
+	return minutes
+}
+
+// setFromSchedule copies the API view of the schedule into the model.
+func (r *ServerRestartScheduleResource) setFromSchedule(m *restartScheduleModel, s scheduleAttributes) {
+	m.ScheduleID = types.Int64Value(s.ID)
+	// Keep the configured spacing when the fields are the same.
+	if strings.Join(strings.Fields(m.Cron.ValueString()), " ") != s.cronExpression() {
+		m.Cron = types.StringValue(s.cronExpression())
+	}
+	m.NextRunAt = types.StringValue(s.NextRunAt)
+	m.ID = types.StringValue(m.ServerID.ValueString() + "-schedule-" + strconv.FormatInt(s.ID, 10))
+}
+
+// execute runs the schedule for execute_now; failures are warnings since the schedule itself is fine.
+func (r *ServerRestartScheduleResource) execute(ctx context.Context, m restartScheduleModel, diags *diag.Diagnostics) {
+	if err := executeSchedule(r.client, m.ServerID.ValueString(), m.ScheduleID.ValueInt64()); err != nil {
+		diags.AddWarning("Schedule Execution Failed", err.Error())
+		return
+	}
+	tflog.Info(ctx, "Executed schedule", map[string]any{"server_id": m.ServerID.ValueString(), "schedule_id": m.ScheduleID.ValueInt64()})
+}
+
+func (r *ServerRestartScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan restartScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spec, tasks, err := r.spec(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Restart Schedule", err.Error())
+		return
+	}
+	schedule, err := createSchedule(r.client, plan.ServerID.ValueString(), spec, tasks)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create restart schedule for server %s: %v", plan.ServerID.ValueString(), err))
+		return
+	}
+
+	r.setFromSchedule(&plan, schedule)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	if !plan.ExecuteNow.IsNull() {
+		r.execute(ctx, plan, &resp.Diagnostics)
+	}
+}
+
+func (r *ServerRestartScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state restartScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := getSchedule(r.client, state.ServerID.ValueString(), state.ScheduleID.ValueInt64())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read restart schedule for server %s: %v", state.ServerID.ValueString(), err))
+		return
+	}
+
+	r.setFromSchedule(&state, schedule)
+	// Optional settings left unset stay null while the panel matches the default.
+	if !state.Name.IsNull() || schedule.Name != "Automatic restart" {
+		state.Name = types.StringValue(schedule.Name)
+	}
+	if !state.IsActive.IsNull() || !schedule.IsActive {
+		state.IsActive = types.BoolValue(schedule.IsActive)
+	}
+	if !state.OnlyWhenOnline.IsNull() || !schedule.OnlyWhenOnline {
+		state.OnlyWhenOnline = types.BoolValue(schedule.OnlyWhenOnline)
+	}
+
+	// Tasks edited in the panel show up as a change of warning_minutes where
+	// that tells them apart; ModifyPlan plans the rebuild either way.
+	expected, err := restartTasks(ctx, state)
+	drifted := err != nil || !scheduleTasksMatch(schedule, expected)
+	if drifted {
+		var minutes []attr.Value
+		for _, m := range warningMinutesFromTasks(schedule) {
+			minutes = append(minutes, types.Int64Value(m))
+		}
+		if len(minutes) > 0 || !state.WarningMinutes.IsNull() {
+			set, diags := types.SetValue(types.Int64Type, minutes)
+			resp.Diagnostics.Append(diags...)
+			state.WarningMinutes = set
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setPrivateJSON(ctx, resp.Private, restartScheduleDriftKey, drifted)...)
+}
+
+func (r *ServerRestartScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state restartScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spec, tasks, err := r.spec(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Restart Schedule", err.Error())
+		return
+	}
+	schedule, err := updateSchedule(r.client, plan.ServerID.ValueString(), state.ScheduleID.ValueInt64(), spec, tasks)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update restart schedule for server %s: %v", plan.ServerID.ValueString(), err))
+		return
+	}
+
+	r.setFromSchedule(&plan, schedule)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setPrivateJSON(ctx, resp.Private, restartScheduleDriftKey, false)...)
+
+	if !plan.ExecuteNow.IsNull() && !plan.ExecuteNow.Equal(state.ExecuteNow) {
+		r.execute(ctx, plan, &resp.Diagnostics)
+	}
+}
+
+func (r *ServerRestartScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state restartScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Delete(schedulePath(state.ServerID.ValueString(), state.ScheduleID.ValueInt64()))
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete restart schedule for server %s: %v", state.ServerID.ValueString(), err))
+	}
+}
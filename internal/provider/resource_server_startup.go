@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource               = &ServerStartupResource{}
+	_ resource.ResourceWithModifyPlan = &ServerStartupResource{}
+)
+
+// ServerStartupResource manages the complete startup configuration of a server (Application API).
+type ServerStartupResource struct {
+	resourceBase
+}
+
+// serverStartupModel holds the resource state.
+type serverStartupModel struct {
+	ServerID    types.Int64  `tfsdk:"server_id"` // internal numeric ID
+	EggID       types.Int64  `tfsdk:"egg_id"`
+	Startup     types.String `tfsdk:"startup"`
+	DockerImage types.String `tfsdk:"docker_image"`
+	Environment types.Map    `tfsdk:"environment"` // every egg variable
+	SkipScripts types.Bool   `tfsdk:"skip_scripts"`
+	ID          types.String `tfsdk:"id"` // synthetic: "<server_id>-startup"
+}
+
+func NewServerStartupResource() resource.Resource {
+	return &ServerStartupResource{resourceBase: newResourceBase("kineticpanel_server_startup", scopeApplication)}
+}
+
+func (r *ServerStartupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_startup"
+}
+
+func (r *ServerStartupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", "Expected the numeric server ID")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), id)...)
+}
+
+func (r *ServerStartupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the startup command, egg, docker image and full environment of a Kinetic Panel server (Application API). " +
+			"Use it for servers the API key's user does not own, where the Client API startup endpoints are not available. " +
+			"`environment` is authoritative: it must hold every variable the egg requires, and any egg variable not listed falls back to its default. " +
+			"Destroying the resource leaves the configuration as is.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Internal numeric server ID (the `id` of `kineticpanel_server`).",
+			},
+			"egg_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Egg of the server. The egg must belong to the server's nest; use `kineticpanel_server_egg` to move between nests.",
+			},
+			"startup": schema.StringAttribute{
+				Required:    true,
+				Description: "Startup command, e.g. `java -Xms128M -Xmx{{SERVER_MEMORY}}M -jar {{SERVER_JARFILE}}`.",
+			},
+			"docker_image": schema.StringAttribute{
+				Required:    true,
+				Description: "Docker image to run the server in.",
+			},
+			"environment": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Value of every egg variable, keyed by environment variable name.",
+			},
+			"skip_scripts": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip the egg's install script on reinstall. Default: false.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-startup`).",
+			},
+		},
+	}
+}
+
+// ModifyPlan checks a changed docker_image against its registry when
+// verify_docker_images is set.
+func (r *ServerStartupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var plan serverStartupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.DockerImage.IsNull() || plan.DockerImage.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state serverStartupModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if state.DockerImage.Equal(plan.DockerImage) {
+			return
+		}
+	}
+	warnMissingImage(ctx, &resp.Diagnostics, r.client, path.Root("docker_image"), plan.DockerImage.ValueString())
+}
+
+// Private state keys: the hash of the startup configuration as last written
+// or read, and the variable names of the server's egg, which rarely change
+// but would otherwise cost an egg lookup on every read.
+const (
+	serverStartupSeenKey = "startup_sha256"
+	serverStartupEggKey  = "egg_variables"
+)
+
+// serverStartupEgg is the cached list of an egg's variables.
+type serverStartupEgg struct {
+	ID        int64    `json:"id"`
+	Variables []string `json:"variables"`
+}
+
+// knows reports whether every key of env is one of the cached variables.
+func (e serverStartupEgg) knows(env map[string]string) bool {
+	for key := range env {
+		if !slices.Contains(e.Variables, key) {
+			return false
+		}
+	}
+	return true
+}
+
+// startupHash hashes what apply writes.
+func startupHash(ctx context.Context, m serverStartupModel) string {
+	env := map[string]string{}
+	m.Environment.ElementsAs(ctx, &env, false)
+	return payloadHash(map[string]any{
+		"egg":          m.EggID.ValueInt64(),
+		"image":        m.DockerImage.ValueString(),
+		"startup":      m.Startup.ValueString(),
+		"environment":  env,
+		"skip_scripts": m.SkipScripts.ValueBool(),
+	})
+}
+
+func (r *ServerStartupResource) apply(ctx context.Context, plan *serverStartupModel) error {
+	env := map[string]string{}
+	if diags := plan.Environment.ElementsAs(ctx, &env, false); diags.HasError() {
+		return fmt.Errorf("invalid environment: %v", diags)
+	}
+	serverID := plan.ServerID.ValueInt64()
+	if err := updateAppServerStartup(r.client, serverID, plan.EggID.ValueInt64(), plan.DockerImage.ValueString(), plan.Startup.ValueString(), env, plan.SkipScripts.ValueBool()); err != nil {
+		return err
+	}
+	plan.ID = types.StringValue(strconv.FormatInt(serverID, 10) + "-startup")
+	return nil
+}
+
+func (r *ServerStartupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverStartupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update startup for server %d: %v", plan.ServerID.ValueInt64(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setPrivateJSON(ctx, resp.Private, serverStartupSeenKey, startupHash(ctx, plan))...)
+}
+
+func (r *ServerStartupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverStartupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := state.ServerID.ValueInt64()
+	current, err := getAppServerStartup(r.client, serverID)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read server %d: %v", serverID, err))
+		return
+	}
+	// Refetch the egg when state holds a variable the cache does not know,
+	// which happens after a variable is added to the egg.
+	var cached serverStartupEgg
+	stateEnv := map[string]string{}
+	resp.Diagnostics.Append(state.Environment.ElementsAs(ctx, &stateEnv, false)...)
+	if !getPrivateJSON(ctx, req.Private, serverStartupEggKey, &cached) || cached.ID != current.Egg || !cached.knows(stateEnv) {
+		egg, err := getEgg(r.client, current.Nest, current.Egg)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read egg %d: %v", current.Egg, err))
+			return
+		}
+		cached = serverStartupEgg{ID: egg.ID, Variables: []string{}}
+		for _, v := range egg.variables() {
+			cached.Variables = append(cached.Variables, v.EnvVariable)
+		}
+	}
+
+	// The container environment also holds panel-provided values such as
+	// SERVER_MEMORY and P_SERVER_UUID; keep only the egg's variables.
+	containerEnv := current.environment()
+	env := map[string]string{}
+	for _, key := range cached.Variables {
+		if val, ok := containerEnv[key]; ok {
+			env[key] = val
+		}
+	}
+	envMap, diags := stringMapValue(env)
+	resp.Diagnostics.Append(diags...)
+
+	state.EggID = types.Int64Value(current.Egg)
+	state.Startup = types.StringValue(current.Container.StartupCommand)
+	state.DockerImage = types.StringValue(current.Container.Image)
+	state.Environment = envMap
+	state.ID = types.StringValue(strconv.FormatInt(serverID, 10) + "-startup")
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setPrivateJSON(ctx, resp.Private, serverStartupEggKey, cached)...)
+	resp.Diagnostics.Append(setPrivateJSON(ctx, resp.Private, serverStartupSeenKey, startupHash(ctx, state))...)
+}
+
+func (r *ServerStartupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverStartupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var seen string
+	if getPrivateJSON(ctx, req.Private, serverStartupSeenKey, &seen) && seen == startupHash(ctx, plan) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	if err := r.apply(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update startup for server %d: %v", plan.ServerID.ValueInt64(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setPrivateJSON(ctx, resp.Private, serverStartupSeenKey, startupHash(ctx, plan))...)
+}
+
+func (r *ServerStartupResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: a server always has a startup configuration
+	resp.State.RemoveResource(ctx)
+}
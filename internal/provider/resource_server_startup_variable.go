@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -13,11 +14,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ resource.Resource = &ServerStartupVariableResource{}
+var (
+	_ resource.Resource               = &ServerStartupVariableResource{}
+	_ resource.ResourceWithModifyPlan = &ServerStartupVariableResource{}
+)
 
 // ServerStartupVariableResource updates a single startup environment variable.
 type ServerStartupVariableResource struct {
-	client *Client
+	resourceBase
 }
 
 // variableModel holds the resource state.
@@ -25,11 +29,13 @@ type variableModel struct {
 	ServerID types.String `tfsdk:"server_id"`
 	Key      types.String `tfsdk:"key"`   // e.g. "MEMORYSIZE"
 	Value    types.String `tfsdk:"value"` // e.g. "2048"
-	ID       types.String `tfsdk:"id"`    // synthetic: "<server_id>-var-<key>"
+	Validate types.Bool   `tfsdk:"validate_on_plan"`
+	Restore  types.Bool   `tfsdk:"restore_default_on_destroy"`
+	ID       types.String `tfsdk:"id"` // synthetic: "<server_id>-var-<key>"
 }
 
 func NewServerStartupVariableResource() resource.Resource {
-	return &ServerStartupVariableResource{}
+	return &ServerStartupVariableResource{resourceBase: newResourceBase("kineticpanel_server_startup_variable", scopeClient)}
 }
 
 func (r *ServerStartupVariableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,19 +43,13 @@ func (r *ServerStartupVariableResource) Metadata(_ context.Context, req resource
 }
 
 func (r *ServerStartupVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ":")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid Import ID", "Expected format: <server_id>:<key>")
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), parts[1])...)
+	importComposite(ctx, req, resp, serverIDPart, importIDPart{Attr: "key", Label: "<env_variable>"})
 }
 
 func (r *ServerStartupVariableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Updates a single startup environment variable for a Kinetic Panel server (Client API).",
+		Description: "Updates a single startup environment variable for a Kinetic Panel server (Client API). " +
+			"Destroying the resource writes the egg's default value back unless `restore_default_on_destroy` is false.",
 		Attributes: map[string]schema.Attribute{
 			"server_id": schema.StringAttribute{
 				Required: true,
@@ -69,6 +69,14 @@ func (r *ServerStartupVariableResource) Schema(_ context.Context, _ resource.Sch
 				Required:    true,
 				Description: "Value to set for the variable.",
 			},
+			"validate_on_plan": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Fetch the server's variables during plan and fail if `key` does not exist, is not editable, or `value` breaks the egg's validation rules, instead of failing at apply. Needs the server to exist at plan time. Default: false.",
+			},
+			"restore_default_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Description: "On destroy, set the variable back to the egg's default instead of leaving the last value behind. Default: true.",
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -80,19 +88,54 @@ func (r *ServerStartupVariableResource) Schema(_ context.Context, _ resource.Sch
 	}
 }
 
-func (r *ServerStartupVariableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ModifyPlan checks key and value against the server's variables when
+// validate_on_plan is set.
+func (r *ServerStartupVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan variableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.Validate.ValueBool() {
+		return
+	}
+	if plan.ServerID.IsUnknown() || plan.Key.IsUnknown() || plan.Value.IsUnknown() {
+		return
+	}
+
+	serverID, key := plan.ServerID.ValueString(), plan.Key.ValueString()
+	vars, err := listStartupVariables(r.client, serverID)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Startup Variable Not Validated", fmt.Sprintf("Failed to fetch startup variables for server %s: %v", serverID, err))
 		return
 	}
-	client, ok := req.ProviderData.(*Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
-		)
+
+	var known []string
+	for _, v := range vars {
+		if v.EnvVariable != key {
+			known = append(known, v.EnvVariable)
+			continue
+		}
+		if !v.IsEditable {
+			resp.Diagnostics.AddAttributeError(path.Root("key"), "Startup Variable Not Editable",
+				fmt.Sprintf("Variable %s of server %s cannot be changed with this API key.", key, serverID))
+			return
+		}
+		warnings, err := validateVariableValue(plan.Value.ValueString(), v.Rules)
+		for _, w := range warnings {
+			resp.Diagnostics.AddAttributeWarning(path.Root("value"), "Startup Variable Rule Not Checked",
+				fmt.Sprintf("Variable %s: %s; the panel checks it at apply.", key, w))
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid Startup Variable Value",
+				fmt.Sprintf("Variable %s (rules %q): %v", key, v.Rules, err))
+		}
 		return
 	}
-	r.client = client
+	resp.Diagnostics.AddAttributeError(path.Root("key"), "Unknown Startup Variable",
+		fmt.Sprintf("Server %s has no startup variable %s visible to this API key. Available: %s", serverID, key, strings.Join(known, ", ")))
 }
 
 func (r *ServerStartupVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -124,7 +167,28 @@ func (r *ServerStartupVariableResource) Read(ctx context.Context, req resource.R
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	// No read-back — use data_server_startup to verify
+	// No read-back — use data_server_startup to verify. Imports start from
+	// the current value.
+	if state.Value.IsNull() {
+		serverID, key := state.ServerID.ValueString(), state.Key.ValueString()
+		vars, err := listStartupVariables(r.client, serverID)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch startup variables for server %s: %v", serverID, err))
+			return
+		}
+		found := false
+		for _, v := range vars {
+			if v.EnvVariable == key {
+				state.Value = types.StringValue(v.ServerValue)
+				found = true
+			}
+		}
+		if !found {
+			resp.Diagnostics.AddError("Unknown Variable", fmt.Sprintf("Server %s has no startup variable %s.", serverID, key))
+			return
+		}
+		state.ID = types.StringValue(serverID + "-var-" + key)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -151,7 +215,34 @@ func (r *ServerStartupVariableResource) Update(ctx context.Context, req resource
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-func (r *ServerStartupVariableResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No-op: cannot delete variables via API
-	resp.State.RemoveResource(ctx)
+// Delete writes the egg's default back, as variables cannot be deleted.
+func (r *ServerStartupVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state variableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || (!state.Restore.IsNull() && !state.Restore.ValueBool()) {
+		return
+	}
+
+	serverID, key := state.ServerID.ValueString(), state.Key.ValueString()
+	vars, err := listStartupVariables(r.client, serverID)
+	if err != nil {
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch startup variables for server %s: %v", serverID, err))
+		return
+	}
+	idx := slices.IndexFunc(vars, func(v clientStartupVariable) bool { return v.EnvVariable == key })
+	switch {
+	case idx < 0:
+		// The egg no longer has the variable; nothing to restore.
+	case vars[idx].Name == "":
+		// Panels that only send the flat environment do not report defaults.
+		resp.Diagnostics.AddWarning("Startup Variable Not Restored",
+			fmt.Sprintf("Server %s does not report the default of %s, so it keeps its current value %q.", serverID, key, vars[idx].ServerValue))
+	case vars[idx].ServerValue != vars[idx].DefaultValue:
+		if err := setStartupVariable(r.client, serverID, key, vars[idx].DefaultValue); err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to restore the default of %s on server %s: %v", key, serverID, err))
+		}
+	}
 }
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ServerStartupVariablesResource{}
+
+// ServerStartupVariablesResource manages many startup variables of a server at once.
+type ServerStartupVariablesResource struct {
+	resourceBase
+}
+
+// startupVariablesModel holds the resource state.
+type startupVariablesModel struct {
+	ServerID  types.String `tfsdk:"server_id"`
+	Variables types.Map    `tfsdk:"variables"` // only the keys managed by Terraform
+	ID        types.String `tfsdk:"id"`        // synthetic: "<server_id>-vars"
+}
+
+func NewServerStartupVariablesResource() resource.Resource {
+	return &ServerStartupVariablesResource{resourceBase: newResourceBase("kineticpanel_server_startup_variables", scopeClient)}
+}
+
+func (r *ServerStartupVariablesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_startup_variables"
+}
+
+func (r *ServerStartupVariablesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importComposite(ctx, req, resp, serverIDPart)
+}
+
+func (r *ServerStartupVariablesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets several startup variables of a Kinetic Panel server from one map (Client API). " +
+			"Only variables whose value differs from the server are sent, and drift is detected per key. " +
+			"Variables removed from the map, or the whole map on destroy, keep their current value because the API cannot unset them.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"variables": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Variable values keyed by environment variable name (e.g. `SERVER_JARFILE`, `MC_VERSION`).",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-vars`).",
+			},
+		},
+	}
+}
+
+// apply sends every planned variable whose value differs from the server.
+func (r *ServerStartupVariablesResource) apply(ctx context.Context, plan startupVariablesModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	wanted := map[string]string{}
+	diags.Append(plan.Variables.ElementsAs(ctx, &wanted, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	serverID := plan.ServerID.ValueString()
+	vars, err := listStartupVariables(r.client, serverID)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to fetch startup variables for server %s: %v", serverID, err))
+		return diags
+	}
+	current := map[string]string{}
+	for _, v := range vars {
+		current[v.EnvVariable] = v.ServerValue
+	}
+
+	keys := make([]string, 0, len(wanted))
+	for k := range wanted {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// Keep going after a failure so one apply reports every rejected variable.
+	for _, k := range keys {
+		if cur, ok := current[k]; ok && cur == wanted[k] {
+			continue
+		}
+		if err := setStartupVariable(r.client, serverID, k, wanted[k]); err != nil {
+			diags.AddAttributeError(path.Root("variables").AtMapKey(k), "Failed to update startup variable", err.Error())
+		}
+	}
+	return diags
+}
+
+func (r *ServerStartupVariablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan startupVariablesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-vars")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerStartupVariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state startupVariablesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := state.ServerID.ValueString()
+	vars, err := listStartupVariables(r.client, serverID)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch startup variables for server %s: %v", serverID, err))
+		return
+	}
+	current := map[string]string{}
+	for _, v := range vars {
+		current[v.EnvVariable] = v.ServerValue
+	}
+
+	// After import nothing is managed yet, so adopt every editable variable.
+	observed := map[string]string{}
+	if state.Variables.IsNull() {
+		for _, v := range vars {
+			if v.IsEditable {
+				observed[v.EnvVariable] = v.ServerValue
+			}
+		}
+	} else {
+		managed := map[string]string{}
+		resp.Diagnostics.Append(state.Variables.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for k := range managed {
+			if v, ok := current[k]; ok {
+				observed[k] = v
+			}
+		}
+	}
+
+	m, diags := stringMapValue(observed)
+	resp.Diagnostics.Append(diags...)
+	state.Variables = m
+	state.ID = types.StringValue(serverID + "-vars")
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerStartupVariablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan startupVariablesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServerID.ValueString() + "-vars")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerStartupVariablesResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: cannot delete variables via API
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ServerWaitResource{}
+
+// ServerWaitResource blocks the apply until a server reaches a state.
+type ServerWaitResource struct {
+	resourceBase
+}
+
+// serverWaitModel holds the resource state.
+type serverWaitModel struct {
+	ServerID types.String `tfsdk:"server_id"`
+	State    types.String `tfsdk:"state"` // running, offline or installed
+	Timeout  types.Int64  `tfsdk:"timeout_seconds"`
+	Interval types.Int64  `tfsdk:"interval_seconds"`
+	Triggers types.Map    `tfsdk:"triggers"` // wait again when changed
+	ID       types.String `tfsdk:"id"`       // synthetic: "<server_id>-wait-<state>"
+}
+
+func NewServerWaitResource() resource.Resource {
+	return &ServerWaitResource{resourceBase: newResourceBase("kineticpanel_server_wait", scopeClient)}
+}
+
+func (r *ServerWaitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_wait"
+}
+
+func (r *ServerWaitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Waits until a Kinetic Panel server reaches a state (Client API), to order steps such as " +
+			"restore backup → wait `offline` → start → wait `running` → run a command through `depends_on`. " +
+			"The wait runs on create; changing `server_id`, `state` or `triggers` replaces the resource, which waits again. Destroying the resource does nothing.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Short server identifier (e.g. `abc123`).",
+			},
+			"state": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("running", "offline", "installed"),
+				},
+				Description: "State to wait for: `running`, `offline`, or `installed` (the install script is no longer running).",
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Fail the apply if the state is not reached in time. Default: 300.",
+			},
+			"interval_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 300),
+				},
+				Description: "Time between polls. Default: 5.",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that re-fire the wait when changed (e.g. the ID of the power action it follows).",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<server_id>-wait-<state>`).",
+			},
+		},
+	}
+}
+
+// clientServerReached reports whether the server is in state. Conflicts (the
+// server is installing or being transferred) count as "not yet".
+func clientServerReached(client *Client, serverID, state string) (bool, string, error) {
+	if state == "installed" {
+		installing, err := clientServerInstalling(client, serverID)
+		if err != nil {
+			return false, "", err
+		}
+		if installing {
+			return false, "installing", nil
+		}
+		return true, "installed", nil
+	}
+
+	status, err := fetchServerStatus(client, serverID)
+	if err != nil {
+		if classify(err) == errClassBusy {
+			return false, "unavailable", nil
+		}
+		return false, "", err
+	}
+	return status.CurrentState == state, status.CurrentState, nil
+}
+
+// waitForClientState polls a server until it reaches state.
+func waitForClientState(ctx context.Context, client *Client, serverID, state string, timeout, interval time.Duration) error {
+	return poll(ctx, pollOptions{
+		What:     "server state",
+		Fields:   map[string]any{"server_id": serverID, "want": state},
+		Interval: interval,
+		Timeout:  timeout,
+		TimeoutError: func(current string) error {
+			return fmt.Errorf("server %s did not reach state %q within %s; last state was %q", serverID, state, timeout, current)
+		},
+	}, func() (bool, string, error) {
+		ok, current, err := clientServerReached(client, serverID, state)
+		if err != nil {
+			return false, "", fmt.Errorf("fetch state of server %s: %w", serverID, err)
+		}
+		return ok, current, nil
+	})
+}
+
+func (r *ServerWaitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverWaitModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 300 * time.Second
+	if !plan.Timeout.IsNull() {
+		timeout = time.Duration(plan.Timeout.ValueInt64()) * time.Second
+	}
+	interval := 5 * time.Second
+	if !plan.Interval.IsNull() {
+		interval = time.Duration(plan.Interval.ValueInt64()) * time.Second
+	}
+
+	serverID, state := plan.ServerID.ValueString(), plan.State.ValueString()
+	if err := waitForClientState(ctx, r.client, serverID, state, timeout, interval); err != nil {
+		resp.Diagnostics.AddError("Wait Failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(serverID + "-wait-" + state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerWaitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverWaitModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// No read-back — the wait is a one-time action
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ServerWaitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverWaitModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the timing settings change in place; they apply to the next wait.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServerWaitResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: nothing to undo
+	resp.State.RemoveResource(ctx)
+}
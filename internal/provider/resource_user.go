@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &UserResource{}
+	_ resource.ResourceWithImportState = &UserResource{}
+)
+
+// UserResource manages a panel user as an administrator.
+type UserResource struct {
+	resourceBase
+}
+
+// userModel holds the resource state.
+type userModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	UUID       types.String `tfsdk:"uuid"`
+	ExternalID types.String `tfsdk:"external_id"`
+	Email      types.String `tfsdk:"email"`
+	Username   types.String `tfsdk:"username"`
+	FirstName  types.String `tfsdk:"first_name"`
+	LastName   types.String `tfsdk:"last_name"`
+	Language   types.String `tfsdk:"language"`
+	Password   types.String `tfsdk:"password"` // write-only; never read back
+	RootAdmin  types.Bool   `tfsdk:"root_admin"`
+	RoleIDs    types.Set    `tfsdk:"role_ids"` // only synced when set
+	TwoFactor  types.Bool   `tfsdk:"two_factor_enabled"`
+}
+
+// emailPattern is a loose email check; the panel validates properly.
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+$`)
+
+func NewUserResource() resource.Resource {
+	return &UserResource{resourceBase: newResourceBase("kineticpanel_user", scopeApplication)}
+}
+
+func (r *UserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// ImportState accepts the numeric user ID or `external:<external_id>`.
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if externalID, ok := strings.CutPrefix(req.ID, "external:"); ok {
+		if externalID == "" {
+			resp.Diagnostics.AddError("Invalid Import ID", "Expected `external:<external_id>` with a non-empty external ID.")
+			return
+		}
+		if r.client == nil {
+			resp.Diagnostics.AddError("Unconfigured Provider", "Importing by external ID needs a configured provider.")
+			return
+		}
+		user, err := getUserByExternalID(r.client, externalID)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to find user with external ID %q: %v", externalID, err))
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), user.ID)...)
+		return
+	}
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected the numeric user ID or `external:<external_id>`, got %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a panel user (Application API), including whether they are a root administrator and, on panels with roles, which roles they hold. " +
+			"Both are read back, so privileges granted in the panel UI show up as drift. " +
+			"Import by user ID, or by the ID of an external system such as billing with `external:<external_id>`. " +
+			"The password is not read back. Destroying the resource deletes the user, which the panel refuses while they own servers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "User ID.",
+			},
+			"uuid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 191),
+				},
+				Description: "ID of the user in an external system, e.g. the billing system's customer ID. Must be unique across the panel.",
+			},
+			"email": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emailPattern, "must be an email address"),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 191),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				Required: true,
+			},
+			"last_name": schema.StringAttribute{
+				Required: true,
+			},
+			"language": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Panel language, e.g. `en`. Default: the panel's default language.",
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+				},
+				Description: "Password. When omitted on create, the panel emails the user a link to set one. Changes are sent; the panel's value is never read back.",
+			},
+			"root_admin": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Whether the user is a root administrator with full access to the panel. Default: false.",
+			},
+			"role_ids": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Roles assigned to the user, on panels that support roles. Roles not listed are removed. When unset, roles are not managed.",
+			},
+			"two_factor_enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the user has two-factor authentication enabled.",
+			},
+		},
+	}
+}
+
+// fromAPI copies a user into m.
+func (m *userModel) fromAPI(u userAttributes) {
+	m.ID = types.Int64Value(u.ID)
+	m.UUID = types.StringValue(u.UUID)
+	m.ExternalID = types.StringPointerValue(u.ExternalID)
+	m.Email = types.StringValue(u.Email)
+	m.Username = types.StringValue(u.Username)
+	m.FirstName = types.StringValue(u.FirstName)
+	m.LastName = types.StringValue(u.LastName)
+	m.Language = types.StringValue(u.Language)
+	m.RootAdmin = types.BoolValue(u.RootAdmin)
+	m.TwoFactor = types.BoolValue(u.TwoFactor)
+}
+
+// syncRoles makes the user's roles match m.RoleIDs, when set, sending only
+// the differences.
+func (r *UserResource) syncRoles(ctx context.Context, m *userModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if m.RoleIDs.IsNull() || m.RoleIDs.IsUnknown() {
+		return diags
+	}
+	var wanted []int64
+	diags.Append(m.RoleIDs.ElementsAs(ctx, &wanted, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	userID := m.ID.ValueInt64()
+	current, err := getUserRoles(r.client, userID)
+	if err != nil {
+		diags.AddAttributeError(path.Root("role_ids"), "API Error", fmt.Sprintf("Failed to read roles of user %d: %v", userID, err))
+		return diags
+	}
+	var add, remove []int64
+	for _, id := range wanted {
+		if !slices.Contains(current, id) {
+			add = append(add, id)
+		}
+	}
+	for _, id := range current {
+		if !slices.Contains(wanted, id) {
+			remove = append(remove, id)
+		}
+	}
+	if err := changeUserRoles(r.client, userID, "assign", add); err != nil {
+		diags.AddAttributeError(path.Root("role_ids"), "API Error", fmt.Sprintf("Failed to assign roles %v to user %d: %v", add, userID, err))
+		return diags
+	}
+	if err := changeUserRoles(r.client, userID, "remove", remove); err != nil {
+		diags.AddAttributeError(path.Root("role_ids"), "API Error", fmt.Sprintf("Failed to remove roles %v from user %d: %v", remove, userID, err))
+	}
+	return diags
+}
+
+// readRoles refreshes m.RoleIDs when roles are managed.
+func (r *UserResource) readRoles(ctx context.Context, m *userModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if m.RoleIDs.IsNull() {
+		return diags
+	}
+	ids, err := getUserRoles(r.client, m.ID.ValueInt64())
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to read roles of user %d: %v", m.ID.ValueInt64(), err))
+		return diags
+	}
+	set, d := types.SetValueFrom(ctx, types.Int64Type, ids)
+	diags.Append(d...)
+	m.RoleIDs = set
+	return diags
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]any{
+		"email":      plan.Email.ValueString(),
+		"username":   plan.Username.ValueString(),
+		"first_name": plan.FirstName.ValueString(),
+		"last_name":  plan.LastName.ValueString(),
+		"root_admin": plan.RootAdmin.ValueBool(),
+	}
+	if !plan.Language.IsUnknown() && !plan.Language.IsNull() {
+		payload["language"] = plan.Language.ValueString()
+	}
+	if !plan.Password.IsNull() {
+		payload["password"] = plan.Password.ValueString()
+	}
+	if !plan.ExternalID.IsNull() {
+		payload["external_id"] = plan.ExternalID.ValueString()
+	}
+	body, err := r.client.Post("/users", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create user %s: %v", plan.Username.ValueString(), err))
+		return
+	}
+	user, err := parseUser(body)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create user %s: %v", plan.Username.ValueString(), err))
+		return
+	}
+	plan.fromAPI(user)
+
+	// Save the user before touching roles, so a failure does not orphan it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.syncRoles(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.readRoles(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := getUser(r.client, state.ID.ValueInt64())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch user %d: %v", state.ID.ValueInt64(), err))
+		return
+	}
+	state.fromAPI(user)
+	resp.Diagnostics.Append(r.readRoles(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := state.ID.ValueInt64()
+	current, err := getUser(r.client, userID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch user %d: %v", userID, err))
+		return
+	}
+	changes := map[string]any{
+		"email":       plan.Email.ValueString(),
+		"username":    plan.Username.ValueString(),
+		"first_name":  plan.FirstName.ValueString(),
+		"last_name":   plan.LastName.ValueString(),
+		"external_id": plan.ExternalID.ValueStringPointer(), // null clears it
+	}
+	if !plan.RootAdmin.IsUnknown() {
+		changes["root_admin"] = plan.RootAdmin.ValueBool()
+	}
+	if !plan.Language.IsUnknown() {
+		changes["language"] = plan.Language.ValueString()
+	}
+	if !plan.Password.IsNull() && !plan.Password.Equal(state.Password) {
+		changes["password"] = plan.Password.ValueString()
+	}
+	user, err := updateUser(r.client, current, changes)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update user %d: %v", userID, err))
+		return
+	}
+	plan.fromAPI(user)
+
+	resp.Diagnostics.Append(r.syncRoles(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.readRoles(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := state.ID.ValueInt64()
+	if err := r.client.Delete(userPath(userID)); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete user %d (they may still own servers): %v", userID, err))
+	}
+}
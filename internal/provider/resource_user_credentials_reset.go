@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &UserCredentialsResetResource{}
+
+// UserCredentialsResetResource resets a user's password and/or disables their two-factor authentication.
+type UserCredentialsResetResource struct {
+	resourceBase
+}
+
+// userCredentialsResetModel holds the resource state.
+type userCredentialsResetModel struct {
+	UserID           types.Int64  `tfsdk:"user_id"`
+	Password         types.String `tfsdk:"password"`
+	DisableTwoFactor types.Bool   `tfsdk:"disable_two_factor"`
+	Triggers         types.Map    `tfsdk:"triggers"`           // reset again when changed
+	TwoFactorEnabled types.Bool   `tfsdk:"two_factor_enabled"` // as reported after the reset
+	ID               types.String `tfsdk:"id"`                 // synthetic: "<user_id>-reset"
+}
+
+func NewUserCredentialsResetResource() resource.Resource {
+	return &UserCredentialsResetResource{resourceBase: newResourceBase("kineticpanel_user_credentials_reset", scopeApplication)}
+}
+
+func (r *UserCredentialsResetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_credentials_reset"
+}
+
+func (r *UserCredentialsResetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Force-resets a panel user's password and/or disables their two-factor authentication (Application API), e.g. for offboarding or incident response. " +
+			"The reset runs on create; changing any argument replaces the resource, which resets again. Destroying the resource does nothing.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "User ID.",
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+				},
+				Description: "New password. When omitted the password is left unchanged.",
+			},
+			"disable_two_factor": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Description: "Turn off the user's two-factor authentication. Default: false. Fails if the panel ignores the change.",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that re-fire the reset when changed (e.g. an incident ticket number).",
+			},
+			"two_factor_enabled": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Whether the user had two-factor authentication enabled after the reset.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Synthetic resource ID (`<user_id>-reset`).",
+			},
+		},
+	}
+}
+
+func (r *UserCredentialsResetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userCredentialsResetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disable2FA := plan.DisableTwoFactor.ValueBool()
+	if plan.Password.IsNull() && !disable2FA {
+		resp.Diagnostics.AddError("Nothing to reset", "Set `password`, `disable_two_factor = true`, or both.")
+		return
+	}
+
+	userID := plan.UserID.ValueInt64()
+	user, err := getUser(r.client, userID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch user %d: %v", userID, err))
+		return
+	}
+
+	changes := map[string]any{}
+	if !plan.Password.IsNull() {
+		changes["password"] = plan.Password.ValueString()
+	}
+	if disable2FA {
+		changes["use_totp"] = false
+	}
+	user, err = updateUser(r.client, user, changes)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to reset credentials for user %d: %v", userID, err))
+		return
+	}
+	if disable2FA && user.TwoFactor {
+		resp.Diagnostics.AddAttributeError(path.Root("disable_two_factor"), "Two-Factor Still Enabled",
+			fmt.Sprintf("The panel accepted the update but user %d still has two-factor authentication enabled; this panel does not allow disabling it through the Application API.", userID))
+		return
+	}
+
+	plan.TwoFactorEnabled = types.BoolValue(user.TwoFactor)
+	plan.ID = types.StringValue(strconv.FormatInt(userID, 10) + "-reset")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *UserCredentialsResetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userCredentialsResetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// No read-back — the reset is a one-time action
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *UserCredentialsResetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userCredentialsResetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every argument forces replacement, so there is nothing to apply here.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *UserCredentialsResetResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: a reset cannot be undone
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Data sources that may be read right after the server was created accept a
+// `retry` block: the panel answers 404 or 409 until the server is installed.
+
+var retryAttrTypes = map[string]attr.Type{
+	"attempts":      types.Int64Type,
+	"delay_seconds": types.Int64Type,
+}
+
+// retrySettings is the decoded `retry` block.
+type retrySettings struct {
+	Attempts types.Int64 `tfsdk:"attempts"`
+	Delay    types.Int64 `tfsdk:"delay_seconds"`
+}
+
+// retryAttribute is the schema of the `retry` block.
+func retryAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Description: "Retry the read while the panel answers 404, 409 for a busy server, or a transient error such as 429, e.g. because the server was created in the same apply and is still installing. " +
+			"Without it the read fails immediately.",
+		Attributes: map[string]schema.Attribute{
+			"attempts": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+				Description: "Total number of tries. Default: 30.",
+			},
+			"delay_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 300),
+				},
+				Description: "Time between tries. Default: 10.",
+			},
+		},
+	}
+}
+
+// withRetry runs op, repeating it as configured by retry while it fails with
+// an error isRetryable accepts. A null retry runs op once.
+func withRetry(ctx context.Context, retry types.Object, op func() error) error {
+	if retry.IsNull() || retry.IsUnknown() {
+		return op()
+	}
+	var settings retrySettings
+	if diags := retry.As(ctx, &settings, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return op()
+	}
+	attempts := int64(30)
+	if !settings.Attempts.IsNull() {
+		attempts = settings.Attempts.ValueInt64()
+	}
+	delay := 10 * time.Second
+	if !settings.Delay.IsNull() {
+		delay = time.Duration(settings.Delay.ValueInt64()) * time.Second
+	}
+
+	var err error
+	for attempt := int64(1); ; attempt++ {
+		err = op()
+		if err == nil || attempt >= attempts {
+			return err
+		}
+		if !isRetryable(err) {
+			return err
+		}
+		tflog.Debug(ctx, "Retrying read", map[string]any{"attempt": attempt, "error": err.Error()})
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
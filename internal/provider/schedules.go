@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Helpers around the Client API schedule endpoints, shared by the schedule
+// convenience resources. A schedule's tasks run in sequence; each task's
+// time_offset is the delay after the previous task (at most 900 seconds).
+
+// maxTaskOffset is the largest time_offset the panel accepts for a task.
+const maxTaskOffset = 900
+
+// scheduleSpec is the writable part of a schedule.
+type scheduleSpec struct {
+	Name           string
+	Cron           string // five fields: minute hour day-of-month month day-of-week
+	IsActive       bool
+	OnlyWhenOnline bool
+}
+
+// scheduleTask is one step of a schedule.
+type scheduleTask struct {
+	Action            string // "command", "power" or "backup"
+	Payload           string
+	TimeOffset        int64 // seconds after the previous task
+	ContinueOnFailure bool
+}
+
+// scheduleAttributes is a schedule as returned by the Client API (with `include=tasks`).
+type scheduleAttributes struct {
+	ID             int64  `json:"id"`
+	Name           string `json:"name"`
+	IsActive       bool   `json:"is_active"`
+	OnlyWhenOnline bool   `json:"only_when_online"`
+	NextRunAt      string `json:"next_run_at"`
+	Cron           struct {
+		Minute     string `json:"minute"`
+		Hour       string `json:"hour"`
+		DayOfMonth string `json:"day_of_month"`
+		Month      string `json:"month"`
+		DayOfWeek  string `json:"day_of_week"`
+	} `json:"cron"`
+	Relationships struct {
+		Tasks struct {
+			Data []struct {
+				Attributes struct {
+					ID                int64  `json:"id"`
+					SequenceID        int64  `json:"sequence_id"`
+					Action            string `json:"action"`
+					Payload           string `json:"payload"`
+					TimeOffset        int64  `json:"time_offset"`
+					ContinueOnFailure bool   `json:"continue_on_failure"`
+				} `json:"attributes"`
+			} `json:"data"`
+		} `json:"tasks"`
+	} `json:"relationships"`
+}
+
+// cronExpression joins the schedule's cron fields back into one expression.
+func (s scheduleAttributes) cronExpression() string {
+	return strings.Join([]string{s.Cron.Minute, s.Cron.Hour, s.Cron.DayOfMonth, s.Cron.Month, s.Cron.DayOfWeek}, " ")
+}
+
+// splitCron splits a five field cron expression.
+func splitCron(expr string) ([]string, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields (minute hour day-of-month month day-of-week), got %d", expr, len(fields))
+	}
+	return fields, nil
+}
+
+func schedulesPath(serverID string) string {
+	return "/servers/" + serverID + "/schedules"
+}
+
+func schedulePath(serverID string, scheduleID int64) string {
+	return schedulesPath(serverID) + "/" + strconv.FormatInt(scheduleID, 10)
+}
+
+func schedulePayload(spec scheduleSpec) (map[string]any, error) {
+	fields, err := splitCron(spec.Cron)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"name":             spec.Name,
+		"is_active":        spec.IsActive,
+		"only_when_online": spec.OnlyWhenOnline,
+		"minute":           fields[0],
+		"hour":             fields[1],
+		"day_of_month":     fields[2],
+		"month":            fields[3],
+		"day_of_week":      fields[4],
+	}, nil
+}
+
+// createSchedule creates a schedule with the given tasks and returns it.
+func createSchedule(client *Client, serverID string, spec scheduleSpec, tasks []scheduleTask) (scheduleAttributes, error) {
+	payload, err := schedulePayload(spec)
+	if err != nil {
+		return scheduleAttributes{}, err
+	}
+	body, err := client.Post(schedulesPath(serverID), payload)
+	if err != nil {
+		return scheduleAttributes{}, err
+	}
+	var apiResp struct {
+		Attributes scheduleAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return scheduleAttributes{}, err
+	}
+
+	scheduleID := apiResp.Attributes.ID
+	if err := replaceScheduleTasks(client, serverID, apiResp.Attributes, tasks); err != nil {
+		// Don't leave a half-built schedule behind.
+		_ = client.Delete(schedulePath(serverID, scheduleID))
+		return scheduleAttributes{}, err
+	}
+	return getSchedule(client, serverID, scheduleID)
+}
+
+// updateSchedule updates the schedule and replaces its tasks when they differ.
+func updateSchedule(client *Client, serverID string, scheduleID int64, spec scheduleSpec, tasks []scheduleTask) (scheduleAttributes, error) {
+	payload, err := schedulePayload(spec)
+	if err != nil {
+		return scheduleAttributes{}, err
+	}
+	// The panel updates schedules with POST, not PATCH.
+	if _, err := client.Post(schedulePath(serverID, scheduleID), payload); err != nil {
+		return scheduleAttributes{}, err
+	}
+
+	current, err := getSchedule(client, serverID, scheduleID)
+	if err != nil {
+		return scheduleAttributes{}, err
+	}
+	if !scheduleTasksMatch(current, tasks) {
+		if err := replaceScheduleTasks(client, serverID, current, tasks); err != nil {
+			return scheduleAttributes{}, err
+		}
+	}
+	return getSchedule(client, serverID, scheduleID)
+}
+
+// getSchedule fetches a schedule including its tasks.
+func getSchedule(client *Client, serverID string, scheduleID int64) (scheduleAttributes, error) {
+	body, err := client.Get(schedulePath(serverID, scheduleID) + "?include=tasks")
+	if err != nil {
+		return scheduleAttributes{}, err
+	}
+	var apiResp struct {
+		Attributes scheduleAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return scheduleAttributes{}, err
+	}
+	return apiResp.Attributes, nil
+}
+
+// replaceScheduleTasks deletes the schedule's tasks and creates tasks in order.
+func replaceScheduleTasks(client *Client, serverID string, current scheduleAttributes, tasks []scheduleTask) error {
+	pth := schedulePath(serverID, current.ID) + "/tasks"
+	for _, t := range current.Relationships.Tasks.Data {
+		if err := client.Delete(pth + "/" + strconv.FormatInt(t.Attributes.ID, 10)); err != nil {
+			return fmt.Errorf("delete task %d: %w", t.Attributes.ID, err)
+		}
+	}
+	for i, t := range tasks {
+		payload := map[string]any{
+			"action":              t.Action,
+			"payload":             t.Payload,
+			"time_offset":         t.TimeOffset,
+			"continue_on_failure": t.ContinueOnFailure,
+		}
+		if _, err := client.Post(pth, payload); err != nil {
+			return fmt.Errorf("create task %d (%s): %w", i+1, t.Action, err)
+		}
+	}
+	return nil
+}
+
+// scheduleTasksMatch reports whether the schedule's tasks are exactly tasks, in order.
+func scheduleTasksMatch(current scheduleAttributes, tasks []scheduleTask) bool {
+	existing := current.Relationships.Tasks.Data
+	if len(existing) != len(tasks) {
+		return false
+	}
+	for i, t := range tasks {
+		a := existing[i].Attributes
+		if a.Action != t.Action || a.Payload != t.Payload || a.TimeOffset != t.TimeOffset || a.ContinueOnFailure != t.ContinueOnFailure {
+			return false
+		}
+	}
+	return true
+}
+
+// executeSchedule runs a schedule once right away, regardless of its cron
+// expression. The panel queues the run and returns immediately.
+func executeSchedule(client *Client, serverID string, scheduleID int64) error {
+	_, err := client.Post(schedulePath(serverID, scheduleID)+"/execute", nil)
+	return err
+}
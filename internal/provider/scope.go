@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// API scopes. Every resource and data source works with exactly one of them,
+// and a provider block talks to one (see use_application).
+const (
+	scopeApplication = "application"
+	scopeClient      = "client"
+)
+
+// keyScope guesses the scope of an API key from its prefix: `ptla_`/`peli_`
+// for Application keys and `ptlc_`/`plcn_` for Client keys. Older keys have
+// no prefix, which returns "".
+func keyScope(apiKey string) string {
+	switch {
+	case strings.HasPrefix(apiKey, "ptla_"), strings.HasPrefix(apiKey, "peli_"):
+		return scopeApplication
+	case strings.HasPrefix(apiKey, "ptlc_"), strings.HasPrefix(apiKey, "plcn_"):
+		return scopeClient
+	}
+	return ""
+}
+
+// scopeGuidance explains how to configure a provider block for scope.
+func scopeGuidance(scope string) string {
+	if scope == scopeApplication {
+		return "Use an Application API key (created under Admin → Application API, usually starting with `ptla_`) with `use_application = true` (the default)."
+	}
+	return "Use a Client API key (created under Account → API Credentials, usually starting with `ptlc_`) with `use_application = false`."
+}
+
+// requireScope adds an error when typeName needs a different API than the
+// provider is configured for. The panel would answer such requests with
+// 403 or 404, which says nothing about the cause.
+func requireScope(diags *diag.Diagnostics, client *Client, typeName, need string) {
+	have, _ := client.apiScope()
+	if have == need {
+		return
+	}
+	diags.AddError("Wrong API Scope",
+		fmt.Sprintf("%s uses the %s API, but this provider is configured for the %s API. %s "+
+			"To use both APIs, declare a second provider block with an alias and set `provider = kineticpanel.<alias>` on the resources that need it.",
+			typeName, need, have, scopeGuidance(need)))
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Graceful shutdown of kineticpanel_server before it is deleted. Power and
+// backups are Client API calls, so they use the resource's client_api_key.
+
+// serverBeforeDestroyModel is the decoded `before_destroy` block.
+type serverBeforeDestroyModel struct {
+	Stop            types.Bool   `tfsdk:"stop"`
+	Timeout         types.Int64  `tfsdk:"timeout_seconds"`
+	FinalBackup     types.Bool   `tfsdk:"final_backup"`
+	FinalBackupPath types.String `tfsdk:"final_backup_path"`
+}
+
+var serverBeforeDestroyAttrTypes = map[string]attr.Type{
+	"stop":              types.BoolType,
+	"timeout_seconds":   types.Int64Type,
+	"final_backup":      types.BoolType,
+	"final_backup_path": types.StringType,
+}
+
+// serverBeforeDestroyAttribute is the schema of the `before_destroy` block.
+func serverBeforeDestroyAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Validators: []validator.Object{
+			objectvalidator.AlsoRequires(path.MatchRoot("client_api_key")),
+		},
+		Description: "Shut the server down gracefully before it is destroyed, and optionally keep a final backup. Needs `client_api_key`. " +
+			"Like other destroy-time settings it takes effect once applied, not when added in the same run as the destroy. " +
+			"If a step fails the server is not deleted; remove the block to destroy it anyway.",
+		Attributes: map[string]schema.Attribute{
+			"stop": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Send the stop signal and wait for the server to go offline, so the game can save and disconnect players. It is killed once `timeout_seconds` pass. Default: true.",
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(10, 3600),
+				},
+				Description: "How long to wait for the server to stop. Default: 300.",
+			},
+			"final_backup": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Take a backup once the server is offline and wait for it to complete. The panel may delete backups together with the server; set `final_backup_path` to keep a copy. Default: false.",
+			},
+			"final_backup_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Local path to download the final backup to. Implies `final_backup`.",
+			},
+		},
+	}
+}
+
+// beforeDestroy runs the `before_destroy` steps of state against the panel
+// of client. A null block does nothing.
+func beforeDestroy(ctx context.Context, client *Client, state serverModel) error {
+	if state.BeforeDestroy.IsNull() || state.BeforeDestroy.IsUnknown() {
+		return nil
+	}
+	var b serverBeforeDestroyModel
+	if diags := state.BeforeDestroy.As(ctx, &b, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return fmt.Errorf("invalid before_destroy: %v", diags)
+	}
+	if state.ClientAPIKey.ValueString() == "" {
+		return errors.New("before_destroy needs client_api_key for the power and backup calls")
+	}
+	cc := client.clientAPI(state.ClientAPIKey.ValueString())
+	identifier := state.Identifier.ValueString()
+
+	timeout := 300 * time.Second
+	if !b.Timeout.IsNull() {
+		timeout = time.Duration(b.Timeout.ValueInt64()) * time.Second
+	}
+	if b.Stop.IsNull() || b.Stop.ValueBool() {
+		if err := stopServer(ctx, cc, identifier, timeout); err != nil {
+			return err
+		}
+	}
+
+	if !b.FinalBackup.ValueBool() && b.FinalBackupPath.IsNull() {
+		return nil
+	}
+	name := "terraform-final-" + time.Now().UTC().Format("20060102-150405")
+	backup, err := createBackup(cc, identifier, name, nil, true)
+	if err != nil {
+		return fmt.Errorf("start final backup: %w", err)
+	}
+	tflog.Info(ctx, "Taking final backup before destroy", map[string]any{"server_id": identifier, "uuid": backup.UUID})
+	if _, err := waitForBackup(ctx, cc, identifier, backup.UUID); err != nil {
+		return fmt.Errorf("final backup: %w", err)
+	}
+	if localPath := b.FinalBackupPath.ValueString(); localPath != "" {
+		if _, err := downloadBackup(ctx, cc, identifier, backup.UUID, localPath); err != nil {
+			return fmt.Errorf("download final backup %s to %s: %w", backup.UUID, localPath, err)
+		}
+	}
+	return nil
+}
+
+// stopServer sends stop and waits for the server to go offline, killing it
+// once timeout passes.
+func stopServer(ctx context.Context, cc *Client, identifier string, timeout time.Duration) error {
+	status, err := fetchServerStatus(cc, identifier)
+	if err != nil {
+		return fmt.Errorf("fetch state of server %s: %w", identifier, err)
+	}
+	if status.CurrentState == "offline" {
+		return nil
+	}
+	if err := sendPower(cc, identifier, "stop"); err != nil {
+		return fmt.Errorf("stop server %s: %w", identifier, err)
+	}
+	err = waitForClientState(ctx, cc, identifier, "offline", timeout, 5*time.Second)
+	if err == nil || ctx.Err() != nil {
+		return err
+	}
+	tflog.Warn(ctx, "Server did not stop in time; killing it", map[string]any{"server_id": identifier, "error": err.Error()})
+	if err := sendPower(cc, identifier, "kill"); err != nil {
+		return fmt.Errorf("kill server %s: %w", identifier, err)
+	}
+	return waitForClientState(ctx, cc, identifier, "offline", 30*time.Second, 2*time.Second)
+}
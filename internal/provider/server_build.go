@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"encoding/json"
+	"net"
+	"strconv"
+)
+
+// Helpers around the build configuration of a server on the Application API.
+
+// appServerBuild is the build part of a server (with `include=allocations`).
+type appServerBuild struct {
+	ID         int64 `json:"id"`
+	Node       int64 `json:"node"`
+	Allocation int64 `json:"allocation"` // primary allocation
+	Limits     struct {
+		Memory      int64   `json:"memory"`
+		Swap        int64   `json:"swap"`
+		Disk        int64   `json:"disk"`
+		IO          int64   `json:"io"`
+		CPU         int64   `json:"cpu"`
+		Threads     *string `json:"threads"`
+		OOMDisabled bool    `json:"oom_disabled"`
+	} `json:"limits"`
+	FeatureLimits struct {
+		Databases   int64 `json:"databases"`
+		Allocations int64 `json:"allocations"`
+		Backups     int64 `json:"backups"`
+	} `json:"feature_limits"`
+	Relationships struct {
+		Allocations struct {
+			Data []struct {
+				Attributes nodeAllocation `json:"attributes"`
+			} `json:"data"`
+		} `json:"allocations"`
+	} `json:"relationships"`
+}
+
+// allocationIDs returns the IDs of every allocation assigned to the server.
+func (b appServerBuild) allocationIDs() []int64 {
+	ids := make([]int64, 0, len(b.Relationships.Allocations.Data))
+	for _, a := range b.Relationships.Allocations.Data {
+		ids = append(ids, a.Attributes.ID)
+	}
+	return ids
+}
+
+// primaryAddress returns the `ip:port` of the server's primary allocation,
+// or "" when it is not among the included allocations.
+func (b appServerBuild) primaryAddress() string {
+	for _, a := range b.Relationships.Allocations.Data {
+		if a.Attributes.ID == b.Allocation {
+			return net.JoinHostPort(a.Attributes.IP, strconv.FormatInt(a.Attributes.Port, 10))
+		}
+	}
+	return ""
+}
+
+// getAppServerBuild fetches a server's limits and allocations.
+func getAppServerBuild(client *Client, serverID int64) (appServerBuild, error) {
+	body, err := client.Get("/servers/" + strconv.FormatInt(serverID, 10) + "?include=allocations")
+	if err != nil {
+		return appServerBuild{}, err
+	}
+	var apiResp struct {
+		Attributes appServerBuild `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return appServerBuild{}, err
+	}
+	return apiResp.Attributes, nil
+}
+
+// updateAppServerBuild patches the build configuration. The panel requires
+// every limit on each update, so they are copied from current unless changes
+// overrides them (e.g. `allocation`, `add_allocations`, `remove_allocations`).
+func updateAppServerBuild(client *Client, current appServerBuild, changes map[string]any) error {
+	payload := map[string]any{
+		"allocation":   current.Allocation,
+		"memory":       current.Limits.Memory,
+		"swap":         current.Limits.Swap,
+		"disk":         current.Limits.Disk,
+		"io":           current.Limits.IO,
+		"cpu":          current.Limits.CPU,
+		"threads":      current.Limits.Threads,
+		"oom_disabled": current.Limits.OOMDisabled,
+		"feature_limits": map[string]int64{
+			"databases":   current.FeatureLimits.Databases,
+			"allocations": current.FeatureLimits.Allocations,
+			"backups":     current.FeatureLimits.Backups,
+		},
+	}
+	for k, v := range changes {
+		payload[k] = v
+	}
+	_, err := client.Patch("/servers/"+strconv.FormatInt(current.ID, 10)+"/build", payload)
+	return err
+}
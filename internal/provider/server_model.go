@@ -0,0 +1,459 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clientServerAttributes is the `attributes` object of a server as returned by
+// the Client API, both by `/servers/{id}` and by the server list. Every server
+// data source decodes into this struct so new fields only need adding once.
+type clientServerAttributes struct {
+	Identifier     string `json:"identifier"`
+	InternalID     int64  `json:"internal_id"`
+	UUID           string `json:"uuid"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	IsSuspended    bool   `json:"is_suspended"`
+	IsInstalling   bool   `json:"is_installing"`
+	IsTransferring bool   `json:"is_transferring"`
+	Node           string `json:"node"`
+	SFTPDetails    struct {
+		IP   string `json:"ip"`
+		Port int64  `json:"port"`
+	} `json:"sftp_details"`
+	Invocation    string   `json:"invocation"`
+	DockerImage   string   `json:"docker_image"`
+	EggFeatures   []string `json:"egg_features"`
+	FeatureLimits struct {
+		Databases   int64 `json:"databases"`
+		Allocations int64 `json:"allocations"`
+		Backups     int64 `json:"backups"`
+	} `json:"feature_limits"`
+	Limits struct {
+		Memory int64 `json:"memory"`
+		Swap   int64 `json:"swap"`
+		Disk   int64 `json:"disk"`
+		IO     int64 `json:"io"`
+		CPU    int64 `json:"cpu"`
+	} `json:"limits"`
+	Relationships struct {
+		Allocations struct {
+			Data []struct {
+				Attributes struct {
+					ID        int64   `json:"id"`
+					IP        string  `json:"ip"`
+					IPAlias   *string `json:"ip_alias"`
+					Port      int64   `json:"port"`
+					Notes     *string `json:"notes"`
+					IsDefault bool    `json:"is_default"`
+				} `json:"attributes"`
+			} `json:"data"`
+		} `json:"allocations"`
+		Variables struct {
+			Data []struct {
+				Attributes struct {
+					EnvVariable string `json:"env_variable"`
+					ServerValue string `json:"server_value"`
+				} `json:"attributes"`
+			} `json:"data"`
+		} `json:"variables"`
+		// Only present when requested through `include`.
+		Egg *struct {
+			Attributes struct {
+				UUID string `json:"uuid"`
+				Name string `json:"name"`
+			} `json:"attributes"`
+		} `json:"egg"`
+		Subusers *struct {
+			Data []struct {
+				Attributes struct {
+					UUID        string   `json:"uuid"`
+					Username    string   `json:"username"`
+					Email       string   `json:"email"`
+					TwoFactor   bool     `json:"2fa_enabled"`
+					Permissions []string `json:"permissions"`
+				} `json:"attributes"`
+			} `json:"data"`
+		} `json:"subusers"`
+	} `json:"relationships"`
+}
+
+// serverIncludes are the relationships the Client API can embed in a server.
+// The first two are always returned; the rest only when listed in `include`.
+var serverIncludes = []string{"allocations", "variables", "egg", "subusers"}
+
+// includeAttribute is the `include` option of the server data sources.
+func includeAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(stringvalidator.OneOf(serverIncludes...)),
+		},
+		Description: "Relationships to embed in the same request: `allocations`, `variables`, `egg` or `subusers`. " +
+			"Allocations and variables are always returned; `egg` and `subusers` fill the attributes of the same name, which are null otherwise.",
+	}
+}
+
+// includeQuery returns the `include` query value for a configured list.
+func includeQuery(ctx context.Context, include types.List) (string, diag.Diagnostics) {
+	if include.IsNull() || include.IsUnknown() {
+		return "", nil
+	}
+	var values []string
+	diags := include.ElementsAs(ctx, &values, false)
+	return strings.Join(values, ","), diags
+}
+
+// clientServerModel holds the attributes every server data source exposes
+// for a server. Its tfsdk tags match serverComputedAttributes, which is the
+// only place their types are declared: state is set from the struct, so
+// there is no attribute type map to keep in sync.
+type clientServerModel struct {
+	Identifier       types.String            `tfsdk:"identifier"`
+	InternalID       types.Int64             `tfsdk:"internal_id"`
+	UUID             types.String            `tfsdk:"uuid"`
+	Name             types.String            `tfsdk:"name"`
+	Description      types.String            `tfsdk:"description"`
+	IsSuspended      types.Bool              `tfsdk:"is_suspended"`
+	IsInstalling     types.Bool              `tfsdk:"is_installing"`
+	IsTransferring   types.Bool              `tfsdk:"is_transferring"`
+	Node             types.String            `tfsdk:"node"`
+	SFTPIP           types.String            `tfsdk:"sftp_ip"`
+	SFTPPort         types.Int64             `tfsdk:"sftp_port"`
+	Invocation       types.String            `tfsdk:"invocation"`
+	DockerImage      types.String            `tfsdk:"docker_image"`
+	Memory           types.Int64             `tfsdk:"memory"`
+	Disk             types.Int64             `tfsdk:"disk"`
+	CPU              types.Int64             `tfsdk:"cpu"`
+	Swap             types.Int64             `tfsdk:"swap"`
+	IO               types.Int64             `tfsdk:"io"`
+	AllocationIP     types.String            `tfsdk:"allocation_ip"`
+	AllocationPort   types.Int64             `tfsdk:"allocation_port"`
+	Address          types.String            `tfsdk:"address"`
+	ConnectionString types.String            `tfsdk:"connection_string"`
+	Allocations      []serverAllocationModel `tfsdk:"allocations"`
+	Environment      map[string]string       `tfsdk:"environment"`
+	EggFeatures      []string                `tfsdk:"egg_features"`
+	FeatureLimits    featureLimitsModel      `tfsdk:"feature_limits"`
+	Egg              *serverEggRefModel      `tfsdk:"egg"`      // nil (null) unless included
+	Subusers         []serverSubuserModel    `tfsdk:"subusers"` // nil (null) unless included
+}
+
+// serverAllocationModel is one entry of the `allocations` list.
+type serverAllocationModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	IP        types.String `tfsdk:"ip"`
+	Alias     types.String `tfsdk:"alias"`
+	Port      types.Int64  `tfsdk:"port"`
+	IsDefault types.Bool   `tfsdk:"is_default"`
+	Notes     types.String `tfsdk:"notes"`
+}
+
+type featureLimitsModel struct {
+	Databases   types.Int64 `tfsdk:"databases"`
+	Allocations types.Int64 `tfsdk:"allocations"`
+	Backups     types.Int64 `tfsdk:"backups"`
+}
+
+type serverEggRefModel struct {
+	UUID types.String `tfsdk:"uuid"`
+	Name types.String `tfsdk:"name"`
+}
+
+type serverSubuserModel struct {
+	UUID             types.String `tfsdk:"uuid"`
+	Username         types.String `tfsdk:"username"`
+	Email            types.String `tfsdk:"email"`
+	TwoFactorEnabled types.Bool   `tfsdk:"two_factor_enabled"`
+	Permissions      []string     `tfsdk:"permissions"`
+}
+
+// serverComputedAttributes returns the data source schema of
+// clientServerModel. A fresh map is returned so callers can add their own keys.
+func serverComputedAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"identifier":      schema.StringAttribute{Computed: true},
+		"internal_id":     schema.Int64Attribute{Computed: true},
+		"uuid":            schema.StringAttribute{Computed: true},
+		"name":            schema.StringAttribute{Computed: true},
+		"description":     schema.StringAttribute{Computed: true},
+		"is_suspended":    schema.BoolAttribute{Computed: true},
+		"is_installing":   schema.BoolAttribute{Computed: true},
+		"is_transferring": schema.BoolAttribute{Computed: true},
+		"node":            schema.StringAttribute{Computed: true},
+		"sftp_ip":         schema.StringAttribute{Computed: true},
+		"sftp_port":       schema.Int64Attribute{Computed: true},
+		"invocation":      schema.StringAttribute{Computed: true},
+		"docker_image":    schema.StringAttribute{Computed: true},
+		"memory":          schema.Int64Attribute{Computed: true},
+		"disk":            schema.Int64Attribute{Computed: true},
+		"cpu":             schema.Int64Attribute{Computed: true},
+		"swap":            schema.Int64Attribute{Computed: true},
+		"io":              schema.Int64Attribute{Computed: true},
+		"allocation_ip":   schema.StringAttribute{Computed: true},
+		"allocation_port": schema.Int64Attribute{Computed: true},
+		"address": schema.StringAttribute{
+			Computed:    true,
+			Description: "`host:port` of the default allocation, using its alias when set.",
+		},
+		"connection_string": schema.StringAttribute{
+			Computed:    true,
+			Description: "What players enter to join, based on the egg: the address without the default port for Minecraft, a `steam://connect/` link for Steam games, otherwise `address`.",
+		},
+		"allocations": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "All allocations assigned to the server, including the default one.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id":         schema.Int64Attribute{Computed: true},
+					"ip":         schema.StringAttribute{Computed: true},
+					"alias":      schema.StringAttribute{Computed: true},
+					"port":       schema.Int64Attribute{Computed: true},
+					"is_default": schema.BoolAttribute{Computed: true},
+					"notes":      schema.StringAttribute{Computed: true},
+				},
+			},
+		},
+		"environment": schema.MapAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"egg_features": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"feature_limits": schema.SingleNestedAttribute{
+			Computed: true,
+			Attributes: map[string]schema.Attribute{
+				"databases":   schema.Int64Attribute{Computed: true},
+				"allocations": schema.Int64Attribute{Computed: true},
+				"backups":     schema.Int64Attribute{Computed: true},
+			},
+		},
+		"egg": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Egg of the server. Only set when `include` contains `egg`.",
+			Attributes: map[string]schema.Attribute{
+				"uuid": schema.StringAttribute{Computed: true},
+				"name": schema.StringAttribute{Computed: true},
+			},
+		},
+		"subusers": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Subusers of the server. Only set when `include` contains `subusers` and the key may read them.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"uuid":               schema.StringAttribute{Computed: true},
+					"username":           schema.StringAttribute{Computed: true},
+					"email":              schema.StringAttribute{Computed: true},
+					"two_factor_enabled": schema.BoolAttribute{Computed: true},
+					"permissions": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenClientServer converts API attributes into the data source model.
+// Collections follow the policy in collections.go, except egg and subusers,
+// which stay null unless included.
+func flattenClientServer(a clientServerAttributes) clientServerModel {
+	m := clientServerModel{
+		Identifier:     types.StringValue(a.Identifier),
+		InternalID:     types.Int64Value(a.InternalID),
+		UUID:           types.StringValue(a.UUID),
+		Name:           types.StringValue(a.Name),
+		Description:    types.StringValue(a.Description),
+		IsSuspended:    types.BoolValue(a.IsSuspended),
+		IsInstalling:   types.BoolValue(a.IsInstalling),
+		IsTransferring: types.BoolValue(a.IsTransferring),
+		Node:           types.StringValue(a.Node),
+		SFTPIP:         types.StringValue(a.SFTPDetails.IP),
+		SFTPPort:       types.Int64Value(a.SFTPDetails.Port),
+		Invocation:     types.StringValue(a.Invocation),
+		DockerImage:    types.StringValue(a.DockerImage),
+		Memory:         types.Int64Value(a.Limits.Memory),
+		Disk:           types.Int64Value(a.Limits.Disk),
+		CPU:            types.Int64Value(a.Limits.CPU),
+		Swap:           types.Int64Value(a.Limits.Swap),
+		IO:             types.Int64Value(a.Limits.IO),
+		Allocations:    []serverAllocationModel{},
+		Environment:    map[string]string{},
+		EggFeatures:    nonNil(a.EggFeatures),
+		FeatureLimits: featureLimitsModel{
+			Databases:   types.Int64Value(a.FeatureLimits.Databases),
+			Allocations: types.Int64Value(a.FeatureLimits.Allocations),
+			Backups:     types.Int64Value(a.FeatureLimits.Backups),
+		},
+	}
+
+	for _, v := range a.Relationships.Variables.Data {
+		m.Environment[v.Attributes.EnvVariable] = v.Attributes.ServerValue
+	}
+
+	var allocIP, address string
+	var allocPort int64
+	for _, alloc := range a.Relationships.Allocations.Data {
+		if alloc.Attributes.IsDefault && allocIP == "" {
+			allocIP = alloc.Attributes.IP
+			allocPort = alloc.Attributes.Port
+			address = allocationAddress(alloc.Attributes.IP, alloc.Attributes.IPAlias, alloc.Attributes.Port)
+		}
+		m.Allocations = append(m.Allocations, serverAllocationModel{
+			ID:        types.Int64Value(alloc.Attributes.ID),
+			IP:        types.StringValue(alloc.Attributes.IP),
+			Alias:     types.StringPointerValue(alloc.Attributes.IPAlias),
+			Port:      types.Int64Value(alloc.Attributes.Port),
+			IsDefault: types.BoolValue(alloc.Attributes.IsDefault),
+			Notes:     types.StringPointerValue(alloc.Attributes.Notes),
+		})
+	}
+	m.AllocationIP = types.StringValue(allocIP)
+	m.AllocationPort = types.Int64Value(allocPort)
+	m.Address = types.StringValue(address)
+	m.ConnectionString = types.StringValue(connectionString(address, allocPort, a.EggFeatures))
+
+	if e := a.Relationships.Egg; e != nil {
+		m.Egg = &serverEggRefModel{
+			UUID: types.StringValue(e.Attributes.UUID),
+			Name: types.StringValue(e.Attributes.Name),
+		}
+	}
+	if su := a.Relationships.Subusers; su != nil {
+		m.Subusers = []serverSubuserModel{}
+		for _, u := range su.Data {
+			m.Subusers = append(m.Subusers, serverSubuserModel{
+				UUID:             types.StringValue(u.Attributes.UUID),
+				Username:         types.StringValue(u.Attributes.Username),
+				Email:            types.StringValue(u.Attributes.Email),
+				TwoFactorEnabled: types.BoolValue(u.Attributes.TwoFactor),
+				Permissions:      nonNil(u.Attributes.Permissions),
+			})
+		}
+	}
+	return m
+}
+
+// allocationAddress returns `host:port` for an allocation, preferring its alias.
+func allocationAddress(ip string, alias *string, port int64) string {
+	host := ip
+	if alias != nil && *alias != "" {
+		host = *alias
+	}
+	return net.JoinHostPort(host, strconv.FormatInt(port, 10))
+}
+
+// Game types guessed from egg features by eggGameType.
+const (
+	gameTypeMinecraft = "minecraft"
+	gameTypeSteam     = "steam"
+	gameTypeOther     = "other"
+)
+
+// eggGameType guesses the kind of game from the egg features: Minecraft eggs
+// carry `eula` or `java_version`, Steam eggs `steam_disk_space`.
+func eggGameType(eggFeatures []string) string {
+	for _, f := range eggFeatures {
+		switch f {
+		case "eula", "java_version":
+			return gameTypeMinecraft
+		case "steam_disk_space":
+			return gameTypeSteam
+		}
+	}
+	return gameTypeOther
+}
+
+// connectionString returns what players enter to join a server at address,
+// based on eggGameType. Anything but Minecraft and Steam gets the plain
+// address.
+func connectionString(address string, port int64, eggFeatures []string) string {
+	if address == "" {
+		return ""
+	}
+	switch eggGameType(eggFeatures) {
+	case gameTypeMinecraft:
+		if port == 25565 {
+			host, _, _ := net.SplitHostPort(address)
+			return host
+		}
+	case gameTypeSteam:
+		return "steam://connect/" + address
+	}
+	return address
+}
+
+// getClientServer fetches a single server from the Client API.
+func getClientServer(client *Client, serverID string) (clientServerAttributes, error) {
+	body, err := client.Get("/servers/" + serverID)
+	if err != nil {
+		return clientServerAttributes{}, err
+	}
+	var apiResp struct {
+		Attributes clientServerAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return clientServerAttributes{}, fmt.Errorf("JSON parse error: %w", err)
+	}
+	return apiResp.Attributes, nil
+}
+
+// listClientServers pages through every server visible to the key. A
+// non-empty nameFilter is passed to the panel as `filter[name]`, which is a
+// partial match; callers re-check it if they need exact semantics. include is
+// passed through as the `include` parameter when set.
+func listClientServers(client *Client, nameFilter, include string) ([]clientServerAttributes, error) {
+	var servers []clientServerAttributes
+	for page, totalPages := 1, 1; page <= totalPages; page++ {
+		q := url.Values{}
+		q.Set("page", fmt.Sprintf("%d", page))
+		if nameFilter != "" {
+			q.Set("filter[name]", nameFilter)
+		}
+		if include != "" {
+			q.Set("include", include)
+		}
+
+		body, err := client.Get("?" + q.Encode())
+		if err != nil {
+			return nil, err
+		}
+
+		var apiResp struct {
+			Data []struct {
+				Attributes clientServerAttributes `json:"attributes"`
+			} `json:"data"`
+			Meta struct {
+				Pagination struct {
+					TotalPages int `json:"total_pages"`
+				} `json:"pagination"`
+			} `json:"meta"`
+		}
+		if err := json.Unmarshal(body, &apiResp); err != nil {
+			return nil, fmt.Errorf("JSON parse error: %w", err)
+		}
+		totalPages = apiResp.Meta.Pagination.TotalPages
+
+		for _, item := range apiResp.Data {
+			servers = append(servers, item.Attributes)
+		}
+	}
+	return servers, nil
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Human-readable sizes for the size functions. The panel takes memory, swap
+// and disk limits in MiB, while usage is reported in bytes.
+
+const mib = 1 << 20
+
+// sizeUnits maps unit suffixes, lower-cased, to bytes. IEC units and the
+// single letters Docker and the JVM use are binary; SI units are decimal.
+var sizeUnits = map[string]float64{
+	"":    mib, // a bare number is already in MiB, like the panel's limits
+	"b":   1,
+	"k":   1 << 10,
+	"kib": 1 << 10,
+	"kb":  1e3,
+	"m":   1 << 20,
+	"mib": 1 << 20,
+	"mb":  1e6,
+	"g":   1 << 30,
+	"gib": 1 << 30,
+	"gb":  1e9,
+	"t":   1 << 40,
+	"tib": 1 << 40,
+	"tb":  1e12,
+	"p":   1 << 50,
+	"pib": 1 << 50,
+	"pb":  1e15,
+}
+
+// humanUnits are the units humanizeBytes picks from, largest first.
+var humanUnits = []struct {
+	name  string
+	bytes int64
+}{
+	{"PiB", 1 << 50},
+	{"TiB", 1 << 40},
+	{"GiB", 1 << 30},
+	{"MiB", 1 << 20},
+	{"KiB", 1 << 10},
+}
+
+// parseSizeMiB parses a size such as `4GiB`, `1.5 G` or `512` (MiB) into
+// whole MiB, rounded down.
+func parseSizeMiB(s string) (int64, error) {
+	trimmed := strings.TrimSpace(s)
+	end := strings.IndexFunc(trimmed, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if end < 0 {
+		end = len(trimmed)
+	}
+	number, unit := trimmed[:end], strings.ToLower(strings.TrimSpace(trimmed[end:]))
+	value, err := strconv.ParseFloat(number, 64)
+	if number == "" || err != nil {
+		return 0, fmt.Errorf("invalid size %q: expected a number with an optional unit such as MiB or GiB", s)
+	}
+	factor, ok := sizeUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("invalid size %q: unknown unit %q", s, trimmed[end:])
+	}
+	result := math.Floor(value * factor / mib)
+	if result > math.MaxInt64/mib {
+		return 0, fmt.Errorf("invalid size %q: too large", s)
+	}
+	return int64(result), nil
+}
+
+// humanizeBytes renders n bytes in the largest binary unit that keeps the
+// value at least 1, with up to two decimals: `4 GiB`, `1.5 MiB`, `512 B`.
+func humanizeBytes(n int64) string {
+	for _, u := range humanUnits {
+		if n >= u.bytes {
+			value := strconv.FormatFloat(float64(n)/float64(u.bytes), 'f', 2, 64)
+			value = strings.TrimRight(strings.TrimRight(value, "0"), ".")
+			return value + " " + u.name
+		}
+	}
+	return strconv.FormatInt(n, 10) + " B"
+}
@@ -0,0 +1,84 @@
+package provider
+
+import "testing"
+
+func TestParseSizeMiB(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int64
+		wantErr bool
+	}{
+		{in: "512", want: 512},
+		{in: "4GiB", want: 4096},
+		{in: "4 gib", want: 4096},
+		{in: "1.5 G", want: 1536},
+		{in: "512MiB", want: 512},
+		{in: "1M", want: 1},
+		{in: "2048 KiB", want: 2},
+		{in: "1 KiB", want: 0},
+		{in: "1GB", want: 953},
+		{in: "1 MB", want: 0},
+		{in: "1 TiB", want: 1 << 20},
+		{in: "1TB", want: 953674},
+		{in: "2 PiB", want: 2 << 30},
+		{in: "1p", want: 1 << 30},
+		{in: "1 PB", want: 953674316},
+		{in: "1073741824 B", want: 1024},
+		{in: " 8 GiB ", want: 8192},
+		{in: "", wantErr: true},
+		{in: "GiB", wantErr: true},
+		{in: "-1 GiB", wantErr: true},
+		{in: "4 GiBs", wantErr: true},
+		{in: "1.2.3 G", wantErr: true},
+		{in: "9000000000000 PiB", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseSizeMiB(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseSizeMiB(%q) error = %v, want error %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseSizeMiB(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestHumanizeBytes(t *testing.T) {
+	tests := []struct {
+		in   int64
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1 KiB"},
+		{1536, "1.5 KiB"},
+		{1 << 20, "1 MiB"},
+		{1<<20 - 1, "1024 KiB"},
+		{4 << 30, "4 GiB"},
+		{5 << 39, "2.5 TiB"},
+		{1234567890, "1.15 GiB"},
+		{3 << 50, "3 PiB"},
+		{1 << 60, "1024 PiB"},
+	}
+	for _, tt := range tests {
+		if got := humanizeBytes(tt.in); got != tt.want {
+			t.Errorf("humanizeBytes(%d) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// TestHumanizeBytesRoundTrip checks that parse_size reads what
+// humanize_bytes writes, for sizes humanize_bytes renders exactly.
+func TestHumanizeBytesRoundTrip(t *testing.T) {
+	for _, n := range []int64{1 << 20, 1536 << 20, 4 << 30, 5 << 39, 3 << 50} {
+		got, err := parseSizeMiB(humanizeBytes(n))
+		if err != nil {
+			t.Errorf("parseSizeMiB(humanizeBytes(%d)): %v", n, err)
+			continue
+		}
+		if got != n/mib {
+			t.Errorf("parseSizeMiB(humanizeBytes(%d)) = %d MiB, want %d", n, got, n/mib)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Helpers around the Client API startup variable endpoints.
+
+// clientStartupVariable is one startup variable as seen by the Client API.
+type clientStartupVariable struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	EnvVariable  string `json:"env_variable"`
+	DefaultValue string `json:"default_value"`
+	ServerValue  string `json:"server_value"`
+	IsEditable   bool   `json:"is_editable"`
+	Rules        string `json:"rules"`
+}
+
+// listStartupVariables returns the variables of a server that are visible to
+// the API key's user. Panels that only send a flat `environment` map yield
+// variables with just the key and value set.
+func listStartupVariables(client *Client, serverID string) ([]clientStartupVariable, error) {
+	body, err := client.Get("/servers/" + serverID + "/startup")
+	if err != nil {
+		return nil, err
+	}
+	var apiResp struct {
+		Data []struct {
+			Attributes clientStartupVariable `json:"attributes"`
+		} `json:"data"`
+		Environment map[string]string `json:"environment"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, err
+	}
+
+	vars := make([]clientStartupVariable, 0, len(apiResp.Data))
+	for _, d := range apiResp.Data {
+		vars = append(vars, d.Attributes)
+	}
+	if len(vars) == 0 {
+		for k, v := range apiResp.Environment {
+			vars = append(vars, clientStartupVariable{EnvVariable: k, ServerValue: v, IsEditable: true})
+		}
+	}
+	return vars, nil
+}
+
+// startupDockerImages returns the images the server's egg allows, keyed by
+// display name, from the startup endpoint's meta.
+func startupDockerImages(client *Client, serverID string) (map[string]string, error) {
+	body, err := client.Get("/servers/" + serverID + "/startup")
+	if err != nil {
+		return nil, err
+	}
+	var apiResp struct {
+		Meta struct {
+			DockerImages map[string]string `json:"docker_images"`
+		} `json:"meta"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, err
+	}
+	return apiResp.Meta.DockerImages, nil
+}
+
+// setStartupVariable updates one variable.
+func setStartupVariable(client *Client, serverID, key, value string) error {
+	_, err := client.Post("/servers/"+serverID+"/startup/variable", map[string]string{
+		"key":   key,
+		"value": value,
+	})
+	return err
+}
+
+// validateVariableValue checks value against the egg's Laravel validation
+// rules (e.g. `required|string|max:20`). Only the common rules are checked;
+// anything else is left for the panel to enforce at apply. Like Laravel, an
+// empty value only fails `required`. warnings name the rules that could not
+// be checked, such as regexes Go's RE2 cannot compile.
+func validateVariableValue(value, rules string) (warnings []string, err error) {
+	parts := splitRules(rules)
+	if value == "" {
+		if slices.Contains(parts, "required") {
+			return nil, fmt.Errorf("a value is required")
+		}
+		return nil, nil
+	}
+
+	numeric := false
+	for _, p := range parts {
+		if p == "integer" || p == "numeric" {
+			numeric = true
+		}
+	}
+
+	for _, p := range parts {
+		name, arg, _ := strings.Cut(p, ":")
+		switch name {
+		case "integer":
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return warnings, fmt.Errorf("%q is not an integer", value)
+			}
+		case "numeric":
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return warnings, fmt.Errorf("%q is not numeric", value)
+			}
+		case "boolean":
+			switch value {
+			case "0", "1", "true", "false":
+			default:
+				return warnings, fmt.Errorf("%q is not a boolean (use 0, 1, true or false)", value)
+			}
+		case "in":
+			if !slices.Contains(strings.Split(arg, ","), value) {
+				return warnings, fmt.Errorf("%q is not one of %s", value, arg)
+			}
+		case "min", "max":
+			limit, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				continue
+			}
+			size := float64(len([]rune(value)))
+			if numeric {
+				if size, err = strconv.ParseFloat(value, 64); err != nil {
+					continue
+				}
+			}
+			if name == "min" && size < limit {
+				return warnings, fmt.Errorf("%q is below the minimum of %s", value, arg)
+			}
+			if name == "max" && size > limit {
+				return warnings, fmt.Errorf("%q is above the maximum of %s", value, arg)
+			}
+		case "between":
+			lo, hi, ok := strings.Cut(arg, ",")
+			if !ok {
+				continue
+			}
+			sub := "min:" + lo + "|max:" + hi
+			if numeric {
+				sub += "|numeric"
+			}
+			if _, err := validateVariableValue(value, sub); err != nil {
+				return warnings, err
+			}
+		case "regex":
+			re, err := phpRegexp(arg)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("rule %s is not checked: %v", p, err))
+				continue
+			}
+			if !re.MatchString(value) {
+				return warnings, fmt.Errorf("%q does not match %s", value, arg)
+			}
+		}
+	}
+	return warnings, nil
+}
+
+// splitRules splits a rule string on `|`, keeping `|` inside a regex rule:
+// a regex rule runs up to the token that closes its delimiter.
+func splitRules(rules string) []string {
+	var parts []string
+	tokens := strings.Split(rules, "|")
+	for i := 0; i < len(tokens); i++ {
+		t := strings.TrimSpace(tokens[i])
+		if expr, ok := strings.CutPrefix(t, "regex:"); ok && !regexClosed(expr) {
+			for j := i + 1; j < len(tokens); j++ {
+				expr += "|" + tokens[j]
+				if regexClosed(expr) {
+					t, i = "regex:"+expr, j
+					break
+				}
+			}
+		}
+		if t != "" {
+			parts = append(parts, t)
+		}
+	}
+	return parts
+}
+
+// regexFlags matches the modifiers after a PHP pattern's closing delimiter.
+var regexFlags = regexp.MustCompile(`^[a-zA-Z]*$`)
+
+// regexClosed reports whether a PHP style `/pattern/flags` regex ends with
+// its closing delimiter and flags.
+func regexClosed(expr string) bool {
+	if len(expr) < 2 {
+		return false
+	}
+	end := strings.LastIndexByte(expr, expr[0])
+	return end > 0 && regexFlags.MatchString(expr[end+1:])
+}
+
+// phpRegexp compiles a PHP style `/pattern/flags` regex. Patterns Go's RE2
+// cannot handle, e.g. with lookarounds, fail.
+func phpRegexp(expr string) (*regexp.Regexp, error) {
+	if !regexClosed(expr) {
+		return nil, fmt.Errorf("%s is not a delimited pattern", expr)
+	}
+	end := strings.LastIndexByte(expr, expr[0])
+	pattern, flags := expr[1:end], expr[end+1:]
+	if strings.ContainsAny(flags, "i") {
+		pattern = "(?i)" + pattern
+	}
+	return regexp.Compile(pattern)
+}
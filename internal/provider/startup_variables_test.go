@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestValidateVariableValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		rules    string
+		wantErr  bool
+		warnings int
+	}{
+		{name: "required empty", value: "", rules: "required|string|max:20", wantErr: true},
+		{name: "required set", value: "survival", rules: "required|string|max:20"},
+		{name: "nullable integer empty", value: "", rules: "nullable|integer"},
+		{name: "optional numeric empty", value: "", rules: "numeric|min:1"},
+		{name: "nullable required empty", value: "", rules: "nullable|required|integer", wantErr: true},
+		{name: "integer", value: "25565", rules: "required|integer"},
+		{name: "not an integer", value: "25.5", rules: "required|integer", wantErr: true},
+		{name: "numeric", value: "25.5", rules: "numeric"},
+		{name: "boolean", value: "true", rules: "boolean"},
+		{name: "not a boolean", value: "yes", rules: "boolean", wantErr: true},
+		{name: "in", value: "hard", rules: "in:easy,normal,hard"},
+		{name: "not in", value: "extreme", rules: "in:easy,normal,hard", wantErr: true},
+		{name: "string max", value: "abcdef", rules: "string|max:5", wantErr: true},
+		{name: "numeric max", value: "500", rules: "integer|max:100", wantErr: true},
+		{name: "numeric min", value: "50", rules: "integer|min:100", wantErr: true},
+		{name: "between", value: "50", rules: "integer|between:1,100"},
+		{name: "outside between", value: "150", rules: "integer|between:1,100", wantErr: true},
+		{name: "regex", value: "1.20.1", rules: `required|regex:/^[0-9.]+$/`},
+		{name: "regex mismatch", value: "latest", rules: `required|regex:/^[0-9.]+$/`, wantErr: true},
+		{name: "regex with alternation", value: "paper", rules: `regex:/^(paper|spigot)$/|max:10`},
+		{name: "regex case insensitive", value: "PAPER", rules: `regex:/^paper$/i`},
+		{name: "unsupported regex warns", value: "abc", rules: `regex:/^(?!root).*$/|max:20`, warnings: 1},
+		{name: "rules after unsupported regex", value: "abcdefghijklmnopqrstuvwxyz", rules: `regex:/^(?!root).*$/|max:20`, wantErr: true, warnings: 1},
+		{name: "unknown rules ignored", value: "x", rules: "string|alpha_dash"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := validateVariableValue(tt.value, tt.rules)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateVariableValue(%q, %q) error = %v, want error %v", tt.value, tt.rules, err, tt.wantErr)
+			}
+			if len(warnings) != tt.warnings {
+				t.Errorf("validateVariableValue(%q, %q) warnings = %q, want %d", tt.value, tt.rules, warnings, tt.warnings)
+			}
+		})
+	}
+}
+
+func TestSplitRules(t *testing.T) {
+	tests := []struct {
+		rules string
+		want  []string
+	}{
+		{"required|string|max:20", []string{"required", "string", "max:20"}},
+		{" nullable | integer ", []string{"nullable", "integer"}},
+		{`required|regex:/^(a|b)$/|max:1`, []string{"required", `regex:/^(a|b)$/`, "max:1"}},
+		{`regex:/^(?!root)(a|b)$/i|max:20`, []string{`regex:/^(?!root)(a|b)$/i`, "max:20"}},
+		{`regex:/^(a|max:20`, []string{`regex:/^(a`, "max:20"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := splitRules(tt.rules); !slices.Equal(got, tt.want) {
+			t.Errorf("splitRules(%q) = %q, want %q", tt.rules, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Sweepers delete what failed acceptance runs leave behind. They only touch
+// objects whose name starts with the sweep prefix, which acceptance
+// configurations must use for everything they create.
+
+// SweepPrefix is the default name prefix of objects created by acceptance tests.
+const SweepPrefix = "tf-acc-"
+
+// sweeper deletes leftovers of one type.
+type sweeper struct {
+	name  string
+	scope string
+	sweep func(ctx context.Context, client *Client, prefix string, dryRun bool) ([]string, error)
+}
+
+// sweepers run in order: servers go before users, since the panel refuses
+// to delete a user who still owns servers.
+var sweepers = []sweeper{
+	{name: "kineticpanel_server", scope: scopeApplication, sweep: sweepServers},
+	{name: "kineticpanel_user", scope: scopeApplication, sweep: sweepUsers},
+	{name: "kineticpanel_server_backup", scope: scopeClient, sweep: sweepBackups},
+}
+
+// Sweep runs the sweepers of the key's scope and returns what was deleted,
+// or with dryRun what would be. It keeps going after a failed sweeper and
+// returns the first error.
+func Sweep(ctx context.Context, host, apiKey string, useApplication bool, prefix string, dryRun bool) ([]string, error) {
+	if len(prefix) < 3 {
+		return nil, fmt.Errorf("refusing to sweep with prefix %q: use at least 3 characters", prefix)
+	}
+	host, err := normalizeHost(host)
+	if err != nil {
+		return nil, err
+	}
+	client := NewClient(host, apiKey, useApplication)
+	scope, _ := client.apiScope()
+
+	var swept []string
+	var firstErr error
+	for _, s := range sweepers {
+		if s.scope != scope {
+			continue
+		}
+		deleted, err := s.sweep(ctx, client, prefix, dryRun)
+		swept = append(swept, deleted...)
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("%s: %w", s.name, err)
+		}
+	}
+	return swept, firstErr
+}
+
+// sweepServers deletes servers named with prefix, forcing the deletion when
+// the node cannot be reached.
+func sweepServers(ctx context.Context, client *Client, prefix string, dryRun bool) ([]string, error) {
+	var ids []int64
+	var names []string
+	err := listAppPages(client, "/servers", appListOptions{Filters: map[string]string{"name": prefix}}, func(raw json.RawMessage) error {
+		var s appServerSummary
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+		if strings.HasPrefix(s.Name, prefix) {
+			ids = append(ids, s.ID)
+			names = append(names, s.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var swept []string
+	for i, id := range ids {
+		if ctx.Err() != nil {
+			return swept, ctx.Err()
+		}
+		if !dryRun {
+			p := "/servers/" + strconv.FormatInt(id, 10)
+			if err := client.Delete(p); err != nil && !isNotFound(err) {
+				if err := client.Delete(p + "/force"); err != nil {
+					return swept, fmt.Errorf("deleting server %d (%s): %w", id, names[i], err)
+				}
+			}
+		}
+		swept = append(swept, fmt.Sprintf("server %d (%s)", id, names[i]))
+	}
+	return swept, nil
+}
+
+// sweepUsers deletes users whose username starts with prefix.
+func sweepUsers(ctx context.Context, client *Client, prefix string, dryRun bool) ([]string, error) {
+	var users []appUser
+	err := listAppPages(client, "/users", appListOptions{Filters: map[string]string{"username": prefix}}, func(raw json.RawMessage) error {
+		var u appUser
+		if err := json.Unmarshal(raw, &u); err != nil {
+			return err
+		}
+		if strings.HasPrefix(u.Username, prefix) {
+			users = append(users, u)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var swept []string
+	for _, u := range users {
+		if ctx.Err() != nil {
+			return swept, ctx.Err()
+		}
+		if !dryRun {
+			if err := client.Delete("/users/" + strconv.FormatInt(u.ID, 10)); err != nil && !isNotFound(err) {
+				return swept, fmt.Errorf("deleting user %d (%s): %w", u.ID, u.Username, err)
+			}
+		}
+		swept = append(swept, fmt.Sprintf("user %d (%s)", u.ID, u.Username))
+	}
+	return swept, nil
+}
+
+// sweepBackups deletes backups named with prefix on every server the key can
+// see, unlocking them first.
+func sweepBackups(ctx context.Context, client *Client, prefix string, dryRun bool) ([]string, error) {
+	servers, err := listClientServers(client, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var swept []string
+	for _, s := range servers {
+		backups, err := listBackups(client, s.Identifier)
+		if err != nil {
+			return swept, fmt.Errorf("listing backups of server %s: %w", s.Identifier, err)
+		}
+		for _, b := range backups {
+			if !strings.HasPrefix(b.Name, prefix) {
+				continue
+			}
+			if ctx.Err() != nil {
+				return swept, ctx.Err()
+			}
+			if !dryRun {
+				if b.IsLocked {
+					if err := toggleBackupLock(client, s.Identifier, b.UUID); err != nil {
+						return swept, fmt.Errorf("unlocking backup %s of server %s: %w", b.UUID, s.Identifier, err)
+					}
+				}
+				if err := client.Delete(backupPath(s.Identifier, b.UUID)); err != nil && !isNotFound(err) {
+					return swept, fmt.Errorf("deleting backup %s of server %s: %w", b.UUID, s.Identifier, err)
+				}
+			}
+			swept = append(swept, fmt.Sprintf("backup %s (%s) of server %s", b.UUID, b.Name, s.Identifier))
+		}
+	}
+	return swept, nil
+}
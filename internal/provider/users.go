@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Helpers around the Application API user endpoints.
+
+// userAttributes is a panel user as returned by the Application API.
+type userAttributes struct {
+	ID         int64   `json:"id"`
+	ExternalID *string `json:"external_id"`
+	UUID       string  `json:"uuid"`
+	Username   string  `json:"username"`
+	Email      string  `json:"email"`
+	FirstName  string  `json:"first_name"`
+	LastName   string  `json:"last_name"`
+	Language   string  `json:"language"`
+	RootAdmin  bool    `json:"root_admin"`
+	TwoFactor  bool    `json:"2fa"`
+}
+
+// parseUser decodes a user response.
+func parseUser(body []byte) (userAttributes, error) {
+	var apiResp struct {
+		Attributes userAttributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return userAttributes{}, err
+	}
+	return apiResp.Attributes, nil
+}
+
+func userPath(userID int64) string {
+	return fmt.Sprintf("/users/%d", userID)
+}
+
+// getUser fetches a user.
+func getUser(client *Client, userID int64) (userAttributes, error) {
+	body, err := client.Get(userPath(userID))
+	if err != nil {
+		return userAttributes{}, err
+	}
+	return parseUser(body)
+}
+
+// getUserByExternalID fetches a user by the ID an external system (e.g.
+// billing) assigned.
+func getUserByExternalID(client *Client, externalID string) (userAttributes, error) {
+	body, err := client.Get("/users/external/" + url.PathEscape(externalID))
+	if err != nil {
+		return userAttributes{}, err
+	}
+	return parseUser(body)
+}
+
+// updateUser patches a user. The panel requires the identity fields on every
+// update, so they are copied from current unless changes overrides them.
+func updateUser(client *Client, current userAttributes, changes map[string]any) (userAttributes, error) {
+	payload := map[string]any{
+		"email":      current.Email,
+		"username":   current.Username,
+		"first_name": current.FirstName,
+		"last_name":  current.LastName,
+	}
+	for k, v := range changes {
+		payload[k] = v
+	}
+	body, err := client.Patch(userPath(current.ID), payload)
+	if err != nil {
+		return userAttributes{}, err
+	}
+	return parseUser(body)
+}
+
+// getUserRoles returns the IDs of the roles assigned to a user. Only panels
+// with roles (Pelican-based) support this; others fail or return none.
+func getUserRoles(client *Client, userID int64) ([]int64, error) {
+	body, err := client.Get(userPath(userID) + "?include=roles")
+	if err != nil {
+		return nil, err
+	}
+	var apiResp struct {
+		Attributes struct {
+			Relationships struct {
+				Roles *struct {
+					Data []struct {
+						Attributes struct {
+							ID int64 `json:"id"`
+						} `json:"attributes"`
+					} `json:"data"`
+				} `json:"roles"`
+			} `json:"relationships"`
+		} `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, err
+	}
+	roles := apiResp.Attributes.Relationships.Roles
+	if roles == nil {
+		return nil, fmt.Errorf("the panel does not report user roles; it may not support them")
+	}
+	ids := make([]int64, 0, len(roles.Data))
+	for _, r := range roles.Data {
+		ids = append(ids, r.Attributes.ID)
+	}
+	return ids, nil
+}
+
+// changeUserRoles assigns (action "assign") or removes (action "remove") roles.
+func changeUserRoles(client *Client, userID int64, action string, roleIDs []int64) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+	_, err := client.Post(userPath(userID)+"/roles/"+action, map[string]any{"roles": roleIDs})
+	return err
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Interactions with a panel can be recorded to a cassette file once and
+// replayed later without the panel, so tests run in CI without credentials:
+//
+//	KINETICPANEL_VCR_MODE=record KINETICPANEL_VCR_CASSETTE=testdata/server.json
+//
+// records every request of every client, and KINETICPANEL_VCR_MODE=replay
+// answers them from the file instead. Cassettes hold no API key, and
+// sensitive JSON fields are redacted as in the debug log, so they can be
+// committed. Requests are matched on method, path, query and body, ignoring
+// the host, in the order they were recorded.
+
+const (
+	vcrRecord = "record"
+	vcrReplay = "replay"
+)
+
+// vcrInteraction is one recorded request and its response.
+type vcrInteraction struct {
+	Method       string `json:"method"`
+	Path         string `json:"path"` // request URI below the host, e.g. /api/client/servers/1a2b3c4d
+	RequestBody  string `json:"request_body,omitempty"`
+	Status       int    `json:"status"`
+	ContentType  string `json:"content_type,omitempty"`
+	ResponseBody string `json:"response_body"`
+}
+
+// vcrTransport records to or replays from a cassette.
+type vcrTransport struct {
+	mode     string
+	cassette string
+	next     http.RoundTripper // used when recording
+
+	mu           sync.Mutex
+	interactions []vcrInteraction
+	used         []bool
+}
+
+var (
+	vcrMu         sync.Mutex
+	vcrTransports = map[string]*vcrTransport{}
+)
+
+// vcrFromEnv returns the transport configured by KINETICPANEL_VCR_MODE, or
+// nil when recording and replaying are off. Clients share one transport per
+// cassette, so a test recording through several clients gets one file.
+func vcrFromEnv() (*vcrTransport, error) {
+	mode := strings.ToLower(os.Getenv("KINETICPANEL_VCR_MODE"))
+	if mode == "" {
+		return nil, nil
+	}
+	if mode != vcrRecord && mode != vcrReplay {
+		return nil, fmt.Errorf("KINETICPANEL_VCR_MODE must be %q or %q, got %q", vcrRecord, vcrReplay, mode)
+	}
+	cassette := os.Getenv("KINETICPANEL_VCR_CASSETTE")
+	if cassette == "" {
+		return nil, errors.New("KINETICPANEL_VCR_CASSETTE must name the cassette file when KINETICPANEL_VCR_MODE is set")
+	}
+
+	vcrMu.Lock()
+	defer vcrMu.Unlock()
+	key := mode + ":" + cassette
+	if t, ok := vcrTransports[key]; ok {
+		return t, nil
+	}
+	t := &vcrTransport{mode: mode, cassette: cassette, next: http.DefaultTransport}
+	if mode == vcrReplay {
+		data, err := os.ReadFile(cassette)
+		if err != nil {
+			return nil, fmt.Errorf("read cassette: %w", err)
+		}
+		if err := json.Unmarshal(data, &t.interactions); err != nil {
+			return nil, fmt.Errorf("parse cassette %s: %w", cassette, err)
+		}
+		t.used = make([]bool, len(t.interactions))
+	}
+	vcrTransports[key] = t
+	return t, nil
+}
+
+// cassetteBody returns body as stored in a cassette: redacted when JSON,
+// verbatim otherwise (e.g. file contents).
+func cassetteBody(body []byte) string {
+	if len(body) > 0 && json.Valid(body) {
+		return redactBody(body)
+	}
+	return string(body)
+}
+
+func (t *vcrTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+	want := vcrInteraction{
+		Method:      req.Method,
+		Path:        req.URL.RequestURI(),
+		RequestBody: cassetteBody(reqBody),
+	}
+	if t.mode == vcrReplay {
+		return t.replay(req, want)
+	}
+	return t.record(req, want)
+}
+
+// replay answers with the first unused interaction matching want.
+func (t *vcrTransport) replay(req *http.Request, want vcrInteraction) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, in := range t.interactions {
+		if t.used[i] || in.Method != want.Method || in.Path != want.Path || in.RequestBody != want.RequestBody {
+			continue
+		}
+		t.used[i] = true
+		header := http.Header{}
+		if in.ContentType != "" {
+			header.Set("Content-Type", in.ContentType)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Status, http.StatusText(in.Status)),
+			StatusCode:    in.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(in.ResponseBody)),
+			ContentLength: int64(len(in.ResponseBody)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("no recorded interaction for %s %s in cassette %s; record it again with KINETICPANEL_VCR_MODE=record", want.Method, want.Path, t.cassette)
+}
+
+// record sends the request to the panel and appends the interaction to the
+// cassette, which is rewritten each time so an aborted run keeps what it got.
+func (t *vcrTransport) record(req *http.Request, in vcrInteraction) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes+1))
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	in.Status = resp.StatusCode
+	in.ContentType = resp.Header.Get("Content-Type")
+	in.ResponseBody = cassetteBody(body)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.interactions = append(t.interactions, in)
+	data, err := json.MarshalIndent(t.interactions, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(t.cassette), 0o755); err != nil {
+		return nil, fmt.Errorf("write cassette: %w", err)
+	}
+	if err := os.WriteFile(t.cassette, append(data, '\n'), 0o644); err != nil {
+		return nil, fmt.Errorf("write cassette: %w", err)
+	}
+	return resp, nil
+}
+
+// vcrErrorTransport fails every request with a misconfiguration error, so it
+// surfaces on the first API call instead of silently reaching the panel.
+type vcrErrorTransport struct{ err error }
+
+func (t vcrErrorTransport) RoundTrip(*http.Request) (*http.Response, error) { return nil, t.err }
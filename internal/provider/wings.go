@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Helpers for talking to a node's daemon (Wings) directly.
+
+// wingsSystemInfo is the daemon's /api/system?v=2 response. Daemons older
+// than the v2 format answer with the flat v1 fields instead, and panels that
+// proxy the call use the admin area's {version, system{type, arch, ...}}.
+type wingsSystemInfo struct {
+	Version string `json:"version"`
+	Docker  struct {
+		Version string `json:"version"`
+	} `json:"docker"`
+	System struct {
+		Architecture  string `json:"architecture"`
+		CPUThreads    int64  `json:"cpu_threads"`
+		MemoryBytes   int64  `json:"memory_bytes"`
+		KernelVersion string `json:"kernel_version"`
+		OS            string `json:"os"`
+		OSType        string `json:"os_type"`
+
+		// The panel's admin system-information format.
+		Type    string `json:"type"`
+		Arch    string `json:"arch"`
+		Release string `json:"release"`
+		CPUs    int64  `json:"cpus"`
+	} `json:"system"`
+
+	// v1 fields.
+	Architecture  string `json:"architecture"`
+	CPUCount      int64  `json:"cpu_count"`
+	KernelVersion string `json:"kernel_version"`
+	OS            string `json:"os"`
+}
+
+// parseWingsSystemInfo decodes any of the response formats into the v2
+// layout. Panel responses may wrap it in "attributes".
+func parseWingsSystemInfo(body []byte) (wingsSystemInfo, error) {
+	var wrapped struct {
+		Attributes json.RawMessage `json:"attributes"`
+	}
+	if err := json.Unmarshal(body, &wrapped); err == nil && len(wrapped.Attributes) > 0 {
+		body = wrapped.Attributes
+	}
+	var info wingsSystemInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return info, fmt.Errorf("JSON parse error: %w", err)
+	}
+	switch {
+	case info.System.Architecture != "":
+	case info.System.Arch != "":
+		info.System.Architecture = info.System.Arch
+		info.System.CPUThreads = info.System.CPUs
+		info.System.KernelVersion = info.System.Release
+		info.System.OSType = info.System.Type
+	default:
+		info.System.Architecture = info.Architecture
+		info.System.CPUThreads = info.CPUCount
+		info.System.KernelVersion = info.KernelVersion
+		info.System.OSType = info.OS
+	}
+	if info.Version == "" {
+		return info, fmt.Errorf("response has no version: %s", bodySnippet(body))
+	}
+	return info, nil
+}
+
+// getPanelSystemInfo asks the panel for the daemon's system information.
+// Only some panels expose this on the Application API; a 404 or 405 means
+// the daemon has to be asked directly.
+func getPanelSystemInfo(client *Client, nodeID int64) (wingsSystemInfo, error) {
+	body, err := client.Get(fmt.Sprintf("/nodes/%d/system-information", nodeID))
+	if err != nil {
+		return wingsSystemInfo{}, err
+	}
+	return parseWingsSystemInfo(body)
+}
+
+// nodeDaemonConfiguration is the part of /nodes/{id}/configuration needed to
+// authenticate against the daemon.
+type nodeDaemonConfiguration struct {
+	TokenID string `json:"token_id"`
+	Token   string `json:"token"`
+}
+
+// getWingsSystemInfo queries the daemon of node with the node's own token,
+// read from the panel. The daemon is reached the way browsers reach it:
+// scheme, fqdn and daemon_listen of the node.
+func getWingsSystemInfo(ctx context.Context, client *Client, node nodeAttributes) (wingsSystemInfo, error) {
+	body, err := client.Get(fmt.Sprintf("/nodes/%d/configuration", node.ID))
+	if err != nil {
+		return wingsSystemInfo{}, fmt.Errorf("reading the node's daemon token: %w", err)
+	}
+	var cfg nodeDaemonConfiguration
+	if err := json.Unmarshal(body, &cfg); err != nil {
+		return wingsSystemInfo{}, fmt.Errorf("reading the node's daemon token: JSON parse error: %w", err)
+	}
+	if cfg.TokenID == "" || cfg.Token == "" {
+		return wingsSystemInfo{}, fmt.Errorf("the panel returned no daemon token for node %d", node.ID)
+	}
+
+	scheme := node.Scheme
+	if scheme == "" {
+		scheme = defaultNodeScheme
+	}
+	url := fmt.Sprintf("%s://%s:%d/api/system?v=2", scheme, node.FQDN, node.DaemonListen)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return wingsSystemInfo{}, err
+	}
+	req.Header.Set("Authorization", "Bearer "+cfg.TokenID+"."+cfg.Token)
+	req.Header.Set("Accept", "application/json")
+
+	// Share the panel client's transport so recorded cassettes cover the
+	// daemon too.
+	httpClient := &http.Client{Timeout: 15 * time.Second, Transport: client.httpClient.Transport}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return wingsSystemInfo{}, fmt.Errorf("daemon at %s:%d is unreachable: %w", node.FQDN, node.DaemonListen, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return wingsSystemInfo{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return wingsSystemInfo{}, fmt.Errorf("daemon returned HTTP %d: %s", resp.StatusCode, bodySnippet(data))
+	}
+	return parseWingsSystemInfo(data)
+}
+
+// compareVersions compares dotted numeric versions such as "v1.11.3" and
+// "1.12". Pre-release and build suffixes are ignored. ok is false when either
+// is not a release version (e.g. "develop").
+func compareVersions(a, b string) (cmp int, ok bool) {
+	parse := func(v string) ([]int64, bool) {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+		if i := strings.IndexAny(v, "-+"); i >= 0 {
+			v = v[:i]
+		}
+		var parts []int64
+		for _, p := range strings.Split(v, ".") {
+			n, err := strconv.ParseInt(p, 10, 64)
+			if err != nil || n < 0 {
+				return nil, false
+			}
+			parts = append(parts, n)
+		}
+		return parts, true
+	}
+	pa, okA := parse(a)
+	pb, okB := parse(b)
+	if !okA || !okB {
+		return 0, false
+	}
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var x, y int64
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		if x != y {
+			if x < y {
+				return -1, true
+			}
+			return 1, true
+		}
+	}
+	return 0, true
+}